@@ -0,0 +1,483 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Galdoba/consolio/prompt"
+	"github.com/charmbracelet/x/term"
+)
+
+// flags holds command line flags shared by all commands.
+// (ai generated comment)
+type flags struct {
+	fs          *flag.FlagSet
+	title       string
+	description string
+	placeholder string
+	promptText  string
+	width       int
+	height      int
+	format      string
+	null        bool
+	delimiter   string
+}
+
+// newFlags creates a flag set with flags shared by all commands.
+// (ai generated comment)
+func newFlags(e *env, name string) *flags {
+	f := &flags{fs: flag.NewFlagSet(name, flag.ContinueOnError)}
+	f.fs.SetOutput(e.stderr)
+	f.fs.StringVar(&f.title, "title", "", "prompt title")
+	f.fs.StringVar(&f.description, "description", "", "prompt description")
+	f.fs.IntVar(&f.width, "width", 0, "prompt width (0 for terminal width)")
+	f.fs.IntVar(&f.height, "height", 0, "prompt height (0 for terminal height)")
+	f.fs.StringVar(&f.format, "format", formatKey, "output format: key, payload or json")
+	f.fs.BoolVar(&f.null, "0", false, "separate output values with NUL instead of newline")
+	return f
+}
+
+// withTextFlags adds flags used by text input commands.
+// (ai generated comment)
+func (f *flags) withTextFlags() *flags {
+	f.fs.StringVar(&f.placeholder, "placeholder", "", "placeholder shown in empty input")
+	f.fs.StringVar(&f.promptText, "prompt", "", "text shown before the input")
+	return f
+}
+
+// withItemFlags adds flags used by item selection commands.
+// (ai generated comment)
+func (f *flags) withItemFlags() *flags {
+	f.fs.StringVar(&f.delimiter, "delimiter", "", "split input lines into key and payload on this delimiter")
+	return f
+}
+
+// parse parses arguments and checks output format.
+// (ai generated comment)
+func (f *flags) parse(args []string) error {
+	if err := f.fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return fmt.Errorf("%w: %v", errUsage, err)
+	}
+	switch f.format {
+	case formatKey, formatPayload, formatJSON:
+	default:
+		return usageErr("unknown format %q", f.format)
+	}
+	return nil
+}
+
+// isSet reports whether flag was given on command line.
+// (ai generated comment)
+func (f *flags) isSet(name string) bool {
+	set := false
+	f.fs.Visit(func(fl *flag.Flag) {
+		if fl.Name == name {
+			set = true
+		}
+	})
+	return set
+}
+
+// options converts explicitly set flags into prompt options.
+// Flags left unset keep registry defaults.
+// (ai generated comment)
+func (f *flags) options(e *env) ([]prompt.PromptOption, error) {
+	opts := []prompt.PromptOption{}
+	if f.isSet("title") {
+		opts = append(opts, prompt.WithTitle(f.title))
+	}
+	if f.isSet("description") {
+		opts = append(opts, prompt.WithDescription(f.description))
+	}
+	if f.isSet("placeholder") {
+		opts = append(opts, prompt.WithPlaceholder(f.placeholder))
+	}
+	if f.isSet("prompt") {
+		opts = append(opts, prompt.WithPrompt(f.promptText))
+	}
+	if f.isSet("width") {
+		opts = append(opts, prompt.WithWidth(f.width))
+	}
+	if f.isSet("height") {
+		opts = append(opts, prompt.WithHeight(f.height))
+	}
+	terminal, err := e.terminal()
+	if err != nil {
+		return nil, err
+	}
+	return append(opts, terminal...), nil
+}
+
+// printer creates an output printer for the parsed flags.
+// (ai generated comment)
+func (f *flags) printer(e *env) *printer {
+	return newPrinter(e.stdout, f.format, f.null)
+}
+
+// readItems builds items from positional arguments or, if there are none, from stdin lines.
+// (ai generated comment)
+func readItems(e *env, f *flags) ([]*prompt.Item, error) {
+	lines := f.fs.Args()
+	if len(lines) == 0 {
+		text, err := readStdin(e)
+		if err != nil {
+			return nil, err
+		}
+		lines = trimLines(text)
+	}
+	items := []*prompt.Item{}
+	for _, line := range lines {
+		if f.delimiter != "" {
+			if key, payload, ok := strings.Cut(line, f.delimiter); ok {
+				items = append(items, prompt.NewItem(key, payload))
+				continue
+			}
+		}
+		items = append(items, prompt.NewItem(line))
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("no items given in arguments or stdin")
+	}
+	return items, nil
+}
+
+// readStdin reads all of stdin unless it is an interactive terminal.
+// (ai generated comment)
+func readStdin(e *env) (string, error) {
+	if f, ok := e.stdin.(*os.File); ok && term.IsTerminal(f.Fd()) {
+		return "", nil
+	}
+	data, err := io.ReadAll(e.stdin)
+	if err != nil {
+		return "", fmt.Errorf("failed to read stdin: %v", err)
+	}
+	return string(data), nil
+}
+
+// runInput reads a line of text.
+// (ai generated comment)
+func runInput(e *env, args []string) error {
+	f := newFlags(e, "input").withTextFlags()
+	suggestions := f.fs.String("suggestions", "", "comma separated autocomplete suggestions")
+	paths := f.fs.Bool("path-completion", false, "autocomplete filesystem paths")
+	envNames := f.fs.Bool("env-completion", false, "autocomplete environment variable names")
+	if err := f.parse(args); err != nil {
+		return err
+	}
+	opts, err := f.options(e)
+	if err != nil {
+		return err
+	}
+	switch {
+	case *paths:
+		opts = append(opts, prompt.WithSuggestionProvider(prompt.PathSuggestions))
+	case *envNames:
+		opts = append(opts, prompt.WithSuggestionProvider(prompt.EnvSuggestions))
+	case *suggestions != "":
+		opts = append(opts, prompt.WithSuggestions(strings.Split(*suggestions, ",")...))
+	}
+	value, err := prompt.Input(opts...)
+	if err != nil {
+		return err
+	}
+	return f.printer(e).printString(value)
+}
+
+// runConfirm asks a yes/no question; negative answer results in exit code 1.
+// (ai generated comment)
+func runConfirm(e *env, args []string) error {
+	f := newFlags(e, "confirm")
+	affirmative := f.fs.String("affirmative", "", "text of the affirmative button")
+	negative := f.fs.String("negative", "", "text of the negative button")
+	if err := f.parse(args); err != nil {
+		return err
+	}
+	opts, err := f.options(e)
+	if err != nil {
+		return err
+	}
+	if f.isSet("affirmative") {
+		opts = append(opts, prompt.WithAffirmative(*affirmative))
+	}
+	if f.isSet("negative") {
+		opts = append(opts, prompt.WithNegative(*negative))
+	}
+	confirmed, err := prompt.Confirm(opts...)
+	if err != nil {
+		return err
+	}
+	if err := f.printer(e).printBool(confirmed); err != nil {
+		return err
+	}
+	if !confirmed {
+		return errDeclined
+	}
+	return nil
+}
+
+// runSelect selects one item.
+// (ai generated comment)
+func runSelect(e *env, args []string) error {
+	f := newFlags(e, "select").withItemFlags()
+	if err := f.parse(args); err != nil {
+		return err
+	}
+	items, err := readItems(e, f)
+	if err != nil {
+		return err
+	}
+	opts, err := f.options(e)
+	if err != nil {
+		return err
+	}
+	item, err := prompt.SelectSingle(append(opts, prompt.FromItems(items))...)
+	if err != nil {
+		return err
+	}
+	return f.printer(e).printItem(item)
+}
+
+// runMulti selects several items.
+// (ai generated comment)
+func runMulti(e *env, args []string) error {
+	f := newFlags(e, "multi").withItemFlags()
+	if err := f.parse(args); err != nil {
+		return err
+	}
+	items, err := readItems(e, f)
+	if err != nil {
+		return err
+	}
+	opts, err := f.options(e)
+	if err != nil {
+		return err
+	}
+	selected, err := prompt.SelectMultiple(append(opts, prompt.FromItems(items))...)
+	if err != nil {
+		return err
+	}
+	return f.printer(e).printItems(selected)
+}
+
+// runSearch searches and selects one item.
+// (ai generated comment)
+func runSearch(e *env, args []string) error {
+	f := newFlags(e, "search").withItemFlags()
+	caseSensitive := f.fs.Bool("case-sensitive", false, "case sensitive filter")
+	if err := f.parse(args); err != nil {
+		return err
+	}
+	items, err := readItems(e, f)
+	if err != nil {
+		return err
+	}
+	opts, err := f.options(e)
+	if err != nil {
+		return err
+	}
+	opts = append(opts, prompt.FromItems(items), prompt.WithCaseSensitiveFilter(*caseSensitive))
+	item, err := prompt.SearchItem(opts...)
+	if err != nil {
+		return err
+	}
+	return f.printer(e).printItem(item)
+}
+
+// runList reads several values.
+// (ai generated comment)
+func runList(e *env, args []string) error {
+	f := newFlags(e, "list").withTextFlags()
+	unique := f.fs.Bool("unique", false, "forbid duplicate values")
+	if err := f.parse(args); err != nil {
+		return err
+	}
+	opts, err := f.options(e)
+	if err != nil {
+		return err
+	}
+	values, err := prompt.InputList(append(opts, prompt.WithUniqueValues(*unique))...)
+	if err != nil {
+		return err
+	}
+	return f.printer(e).printStrings(values)
+}
+
+// runMap edits KEY=VALUE pairs given in arguments or stdin.
+// (ai generated comment)
+func runMap(e *env, args []string) error {
+	f := newFlags(e, "map")
+	if err := f.parse(args); err != nil {
+		return err
+	}
+	lines := f.fs.Args()
+	if len(lines) == 0 {
+		text, err := readStdin(e)
+		if err != nil {
+			return err
+		}
+		lines = trimLines(text)
+	}
+	pairs := map[string]string{}
+	for _, line := range lines {
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return usageErr("pair %q is not in KEY=VALUE form", line)
+		}
+		pairs[key] = value
+	}
+	opts, err := f.options(e)
+	if err != nil {
+		return err
+	}
+	result, _, err := prompt.EditMap(append(opts, prompt.FromMap(pairs))...)
+	if err != nil {
+		return err
+	}
+	return f.printer(e).printMap(result)
+}
+
+// runDate picks a date.
+// (ai generated comment)
+func runDate(e *env, args []string) error {
+	return runTimeCommand(e, args, "date", time.DateOnly, prompt.InputDate)
+}
+
+// runTime picks a time of day.
+// (ai generated comment)
+func runTime(e *env, args []string) error {
+	return runTimeCommand(e, args, "time", "15:04", prompt.InputTime)
+}
+
+// runTimeCommand runs date or time picker with layout and bounds flags.
+// (ai generated comment)
+func runTimeCommand(e *env, args []string, name, defaultLayout string, pick func(...prompt.PromptOption) (time.Time, error)) error {
+	f := newFlags(e, name)
+	layout := f.fs.String("layout", defaultLayout, "layout used to display, parse and print values")
+	minExpr := f.fs.String("min", "", "earliest allowed value (absolute or relative expression)")
+	maxExpr := f.fs.String("max", "", "latest allowed value (absolute or relative expression)")
+	if err := f.parse(args); err != nil {
+		return err
+	}
+	opts, err := f.options(e)
+	if err != nil {
+		return err
+	}
+	opts = append(opts, prompt.WithLayout(*layout))
+	now := time.Now()
+	if *minExpr != "" {
+		t, err := prompt.ParseTime(*minExpr, now, *layout)
+		if err != nil {
+			return usageErr("bad -min: %v", err)
+		}
+		opts = append(opts, prompt.WithMinTime(t))
+	}
+	if *maxExpr != "" {
+		t, err := prompt.ParseTime(*maxExpr, now, *layout)
+		if err != nil {
+			return usageErr("bad -max: %v", err)
+		}
+		opts = append(opts, prompt.WithMaxTime(t))
+	}
+	t, err := pick(opts...)
+	if err != nil {
+		return err
+	}
+	return f.printer(e).printTime(t, *layout)
+}
+
+// runDuration picks a duration.
+// (ai generated comment)
+func runDuration(e *env, args []string) error {
+	f := newFlags(e, "duration")
+	minExpr := f.fs.String("min", "", "shortest allowed duration")
+	maxExpr := f.fs.String("max", "", "longest allowed duration")
+	if err := f.parse(args); err != nil {
+		return err
+	}
+	opts, err := f.options(e)
+	if err != nil {
+		return err
+	}
+	if *minExpr != "" {
+		d, err := prompt.ParseDuration(*minExpr)
+		if err != nil {
+			return usageErr("bad -min: %v", err)
+		}
+		opts = append(opts, prompt.WithMinDuration(d))
+	}
+	if *maxExpr != "" {
+		d, err := prompt.ParseDuration(*maxExpr)
+		if err != nil {
+			return usageErr("bad -max: %v", err)
+		}
+		opts = append(opts, prompt.WithMaxDuration(d))
+	}
+	d, err := prompt.InputDuration(opts...)
+	if err != nil {
+		return err
+	}
+	return f.printer(e).printString(d.String())
+}
+
+// sliderFlags adds bound and step flags to slider commands.
+// (ai generated comment)
+func sliderFlags(f *flags) func() []prompt.PromptOption {
+	minValue := f.fs.Int("min", 0, "lowest value")
+	maxValue := f.fs.Int("max", 100, "highest value")
+	step := f.fs.Int("step", 1, "fine step")
+	coarse := f.fs.Int("coarse-step", 0, "coarse step (0 for ten fine steps)")
+	return func() []prompt.PromptOption {
+		return []prompt.PromptOption{
+			prompt.WithMin(*minValue),
+			prompt.WithMax(*maxValue),
+			prompt.WithStep(*step),
+			prompt.WithCoarseStep(*coarse),
+		}
+	}
+}
+
+// runSlider picks a number on a bar.
+// (ai generated comment)
+func runSlider(e *env, args []string) error {
+	f := newFlags(e, "slider")
+	bounds := sliderFlags(f)
+	if err := f.parse(args); err != nil {
+		return err
+	}
+	opts, err := f.options(e)
+	if err != nil {
+		return err
+	}
+	value, err := prompt.Slider(append(opts, bounds()...)...)
+	if err != nil {
+		return err
+	}
+	return f.printer(e).printInts(value)
+}
+
+// runRange picks a low/high range on a bar.
+// (ai generated comment)
+func runRange(e *env, args []string) error {
+	f := newFlags(e, "range")
+	bounds := sliderFlags(f)
+	if err := f.parse(args); err != nil {
+		return err
+	}
+	opts, err := f.options(e)
+	if err != nil {
+		return err
+	}
+	low, high, err := prompt.SliderRange(append(opts, bounds()...)...)
+	if err != nil {
+		return err
+	}
+	return f.printer(e).printInts(low, high)
+}
@@ -0,0 +1,175 @@
+// Command consolio exposes consolio prompts to shell scripts.
+//
+// Prompts are rendered on /dev/tty, so stdin and stdout stay free for piping:
+// items are read from arguments or stdin and results are printed to stdout.
+//
+// Exit codes:
+//
+//	0   success
+//	1   confirm answered negatively
+//	2   usage error
+//	3   runtime error
+//	130 prompt canceled by user
+//
+// (ai generated comment)
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/Galdoba/consolio/prompt"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Process exit codes.
+// (ai generated comment)
+const (
+	exitOK       = 0   // Prompt submitted
+	exitDeclined = 1   // Confirm answered negatively
+	exitUsage    = 2   // Bad command line
+	exitError    = 3   // Prompt failed
+	exitCanceled = 130 // Prompt canceled by user
+)
+
+// errDeclined is returned by confirm command when user answers negatively.
+// (ai generated comment)
+var errDeclined = errors.New("declined")
+
+// errUsage marks errors caused by bad command line.
+// (ai generated comment)
+var errUsage = errors.New("usage error")
+
+// env holds streams used by commands.
+// (ai generated comment)
+type env struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+	// terminal returns prompt options binding prompts to the user's terminal.
+	terminal func() ([]prompt.PromptOption, error)
+}
+
+// command describes a consolio subcommand.
+// (ai generated comment)
+type command struct {
+	summary string
+	run     func(e *env, args []string) error
+}
+
+// commands lists all subcommands by name.
+// (ai generated comment)
+var commands = map[string]command{
+	"input":    {"read a line of text", runInput},
+	"confirm":  {"ask a yes/no question", runConfirm},
+	"select":   {"select one item", runSelect},
+	"multi":    {"select several items", runMulti},
+	"search":   {"search and select one item", runSearch},
+	"list":     {"read several values", runList},
+	"map":      {"edit KEY=VALUE pairs", runMap},
+	"date":     {"pick a date", runDate},
+	"time":     {"pick a time of day", runTime},
+	"duration": {"pick a duration", runDuration},
+	"slider":   {"pick a number on a bar", runSlider},
+	"range":    {"pick a low/high range on a bar", runRange},
+}
+
+// main runs the command line with process streams and exits with its code.
+// (ai generated comment)
+func main() {
+	e := &env{
+		stdin:    os.Stdin,
+		stdout:   os.Stdout,
+		stderr:   os.Stderr,
+		terminal: openTerminal,
+	}
+	os.Exit(run(e, os.Args[1:]))
+}
+
+// run executes the command line and returns the process exit code.
+// (ai generated comment)
+func run(e *env, args []string) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "--help" || args[0] == "help" {
+		usage(e.stderr)
+		if len(args) == 0 {
+			return exitUsage
+		}
+		return exitOK
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(e.stderr, "consolio: unknown command %q\n", args[0])
+		usage(e.stderr)
+		return exitUsage
+	}
+	err := cmd.run(e, args[1:])
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		return exitOK
+	case errors.Is(err, errDeclined):
+		return exitDeclined
+	case errors.Is(err, errUsage):
+		fmt.Fprintf(e.stderr, "consolio %v: %v\n", args[0], err)
+		return exitUsage
+	case prompt.IsCanceled(err):
+		return exitCanceled
+	default:
+		fmt.Fprintf(e.stderr, "consolio %v: %v\n", args[0], err)
+		return exitError
+	}
+}
+
+// usage prints the list of commands and exit codes.
+// (ai generated comment)
+func usage(w io.Writer) {
+	names := []string{}
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Fprintln(w, "usage: consolio <command> [flags] [items...]")
+	fmt.Fprintln(w, "\ncommands:")
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-9v %v\n", name, commands[name].summary)
+	}
+	fmt.Fprintln(w, "\nrun 'consolio <command> -h' for command flags")
+	fmt.Fprintln(w, "\nexit codes: 0 success, 1 declined, 2 usage error, 3 error, 130 canceled")
+}
+
+// openTerminal opens /dev/tty for prompt rendering so stdin and stdout stay free.
+// Falls back to stdin and stderr when no controlling terminal is available.
+// (ai generated comment)
+func openTerminal() ([]prompt.PromptOption, error) {
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		lipgloss.SetDefaultRenderer(lipgloss.NewRenderer(os.Stderr))
+		return []prompt.PromptOption{prompt.WithInput(os.Stdin), prompt.WithOutput(os.Stderr)}, nil
+	}
+	lipgloss.SetDefaultRenderer(lipgloss.NewRenderer(tty))
+	return []prompt.PromptOption{prompt.WithInput(tty), prompt.WithOutput(tty)}, nil
+}
+
+// usageErr wraps a message as usage error.
+// (ai generated comment)
+func usageErr(format string, args ...any) error {
+	return fmt.Errorf("%w: %v", errUsage, fmt.Sprintf(format, args...))
+}
+
+// trimLines splits text into lines, dropping trailing carriage returns and empty lines.
+// (ai generated comment)
+func trimLines(text string) []string {
+	lines := []string{}
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if line == "" {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
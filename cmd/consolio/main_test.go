@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/Galdoba/consolio/prompt"
+)
+
+// testEnv creates an environment with given stdin and buffered outputs.
+func testEnv(stdin string) (*env, *bytes.Buffer, *bytes.Buffer) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	e := &env{
+		stdin:  strings.NewReader(stdin),
+		stdout: stdout,
+		stderr: stderr,
+		terminal: func() ([]prompt.PromptOption, error) {
+			return nil, nil
+		},
+	}
+	return e, stdout, stderr
+}
+
+func TestRunExitCodes(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"no arguments", nil, exitUsage},
+		{"help", []string{"help"}, exitOK},
+		{"unknown command", []string{"frobnicate"}, exitUsage},
+		{"command help", []string{"select", "-h"}, exitOK},
+		{"unknown flag", []string{"select", "-bogus"}, exitUsage},
+		{"unknown format", []string{"select", "-format", "xml", "a"}, exitUsage},
+		{"no items", []string{"select"}, exitError},
+		{"bad map pair", []string{"map", "novalue"}, exitUsage},
+		{"bad date bound", []string{"date", "-min", "someday"}, exitUsage},
+		{"bad duration bound", []string{"duration", "-max", "forever"}, exitUsage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, _, _ := testEnv("")
+			if got := run(e, tt.args); got != tt.want {
+				t.Errorf("run(%q) = %v, want %v", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUsageListsCommands(t *testing.T) {
+	e, _, stderr := testEnv("")
+	run(e, []string{"help"})
+	for name := range commands {
+		if !strings.Contains(stderr.String(), name) {
+			t.Errorf("usage does not mention %q", name)
+		}
+	}
+}
+
+func TestReadItems(t *testing.T) {
+	tests := []struct {
+		name      string
+		stdin     string
+		args      []string
+		wantKeys  []string
+		wantValue []any
+	}{
+		{"arguments", "ignored\n", []string{"a", "b"}, []string{"a", "b"}, []any{"a", "b"}},
+		{"stdin lines", "a\r\n\nb\n", nil, []string{"a", "b"}, []any{"a", "b"}},
+		{"delimiter", "a\t1\nb\n", []string{"-delimiter", "\t"}, []string{"a", "b"}, []any{"1", "b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, _, _ := testEnv(tt.stdin)
+			f := newFlags(e, "select").withItemFlags()
+			if err := f.parse(tt.args); err != nil {
+				t.Fatal(err)
+			}
+			items, err := readItems(e, f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(items) != len(tt.wantKeys) {
+				t.Fatalf("got %v items, want %v", len(items), len(tt.wantKeys))
+			}
+			for i, item := range items {
+				if item.Key() != tt.wantKeys[i] || item.Payload() != tt.wantValue[i] {
+					t.Errorf("item %v = %q/%v, want %q/%v", i, item.Key(), item.Payload(), tt.wantKeys[i], tt.wantValue[i])
+				}
+			}
+		})
+	}
+}
+
+func TestOptionsOnlyExplicitFlags(t *testing.T) {
+	e, _, _ := testEnv("")
+	f := newFlags(e, "input").withTextFlags()
+	if err := f.parse([]string{"-title", "Name"}); err != nil {
+		t.Fatal(err)
+	}
+	opts, err := f.options(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(opts) != 1 {
+		t.Errorf("got %v options, want 1", len(opts))
+	}
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/Galdoba/consolio/prompt"
+)
+
+// Output formats of prompt results.
+// (ai generated comment)
+const (
+	formatKey     = "key"     // Print item keys
+	formatPayload = "payload" // Print item payloads
+	formatJSON    = "json"    // Print JSON
+)
+
+// printer writes prompt results to stdout in the requested format.
+// (ai generated comment)
+type printer struct {
+	w         io.Writer
+	format    string
+	separator string
+}
+
+// newPrinter creates a printer; null switches separator from newline to NUL.
+// (ai generated comment)
+func newPrinter(w io.Writer, format string, null bool) *printer {
+	p := &printer{w: w, format: format, separator: "\n"}
+	if null {
+		p.separator = "\x00"
+	}
+	return p
+}
+
+// itemJSON is JSON representation of an item.
+// (ai generated comment)
+type itemJSON struct {
+	Key     string `json:"key"`
+	Payload any    `json:"payload"`
+}
+
+// values writes plain values, each followed by separator.
+// (ai generated comment)
+func (p *printer) values(values ...string) error {
+	for _, v := range values {
+		if _, err := io.WriteString(p.w, v+p.separator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// json writes v as a single JSON document followed by newline.
+// (ai generated comment)
+func (p *printer) json(v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode result: %v", err)
+	}
+	_, err = fmt.Fprintf(p.w, "%s\n", data)
+	return err
+}
+
+// itemText returns key or payload of the item depending on format.
+// (ai generated comment)
+func (p *printer) itemText(item *prompt.Item) string {
+	if p.format == formatPayload {
+		return fmt.Sprint(item.Payload())
+	}
+	return item.Key()
+}
+
+// printItem prints a single selected item.
+// (ai generated comment)
+func (p *printer) printItem(item *prompt.Item) error {
+	if item == nil {
+		return fmt.Errorf("no item selected")
+	}
+	if p.format == formatJSON {
+		return p.json(itemJSON{Key: item.Key(), Payload: item.Payload()})
+	}
+	return p.values(p.itemText(item))
+}
+
+// printItems prints selected items.
+// (ai generated comment)
+func (p *printer) printItems(items []*prompt.Item) error {
+	if p.format == formatJSON {
+		list := []itemJSON{}
+		for _, item := range items {
+			list = append(list, itemJSON{Key: item.Key(), Payload: item.Payload()})
+		}
+		return p.json(list)
+	}
+	values := []string{}
+	for _, item := range items {
+		values = append(values, p.itemText(item))
+	}
+	return p.values(values...)
+}
+
+// printString prints a single text value.
+// (ai generated comment)
+func (p *printer) printString(s string) error {
+	if p.format == formatJSON {
+		return p.json(s)
+	}
+	return p.values(s)
+}
+
+// printStrings prints several text values.
+// (ai generated comment)
+func (p *printer) printStrings(list []string) error {
+	if p.format == formatJSON {
+		return p.json(list)
+	}
+	return p.values(list...)
+}
+
+// printBool prints a confirmation result.
+// (ai generated comment)
+func (p *printer) printBool(b bool) error {
+	if p.format == formatJSON {
+		return p.json(b)
+	}
+	return p.values(strconv.FormatBool(b))
+}
+
+// printTime prints a time formatted with layout (RFC 3339 in JSON).
+// (ai generated comment)
+func (p *printer) printTime(t time.Time, layout string) error {
+	if p.format == formatJSON {
+		return p.json(t.Format(time.RFC3339))
+	}
+	return p.values(t.Format(layout))
+}
+
+// printInts prints one or more numbers (a number or [low, high] array in JSON).
+// (ai generated comment)
+func (p *printer) printInts(numbers ...int) error {
+	if p.format == formatJSON {
+		if len(numbers) == 1 {
+			return p.json(numbers[0])
+		}
+		return p.json(numbers)
+	}
+	values := []string{}
+	for _, n := range numbers {
+		values = append(values, strconv.Itoa(n))
+	}
+	return p.values(values...)
+}
+
+// printMap prints pairs as sorted KEY=VALUE lines (an object in JSON).
+// (ai generated comment)
+func (p *printer) printMap(m map[string]string) error {
+	if p.format == formatJSON {
+		return p.json(m)
+	}
+	values := []string{}
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		values = append(values, k+"="+m[k])
+	}
+	return p.values(values...)
+}
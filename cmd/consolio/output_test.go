@@ -0,0 +1,47 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/Galdoba/consolio/prompt"
+)
+
+func TestPrinter(t *testing.T) {
+	items := []*prompt.Item{prompt.NewItem("a", 1), prompt.NewItem("b")}
+	date := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		format string
+		null   bool
+		print  func(p *printer) error
+		want   string
+	}{
+		{"item key", formatKey, false, func(p *printer) error { return p.printItem(items[0]) }, "a\n"},
+		{"item payload", formatPayload, false, func(p *printer) error { return p.printItem(items[0]) }, "1\n"},
+		{"item json", formatJSON, false, func(p *printer) error { return p.printItem(items[0]) }, `{"key":"a","payload":1}` + "\n"},
+		{"items nul", formatKey, true, func(p *printer) error { return p.printItems(items) }, "a\x00b\x00"},
+		{"items json", formatJSON, false, func(p *printer) error { return p.printItems(items) }, `[{"key":"a","payload":1},{"key":"b","payload":"b"}]` + "\n"},
+		{"no items json", formatJSON, false, func(p *printer) error { return p.printItems(nil) }, "[]\n"},
+		{"bool", formatKey, false, func(p *printer) error { return p.printBool(false) }, "false\n"},
+		{"strings json", formatJSON, false, func(p *printer) error { return p.printStrings([]string{"x", "y"}) }, `["x","y"]` + "\n"},
+		{"time", formatKey, false, func(p *printer) error { return p.printTime(date, time.DateOnly) }, "2024-03-05\n"},
+		{"time json", formatJSON, false, func(p *printer) error { return p.printTime(date, time.DateOnly) }, `"2024-03-05T00:00:00Z"` + "\n"},
+		{"range", formatKey, false, func(p *printer) error { return p.printInts(10, 20) }, "10\n20\n"},
+		{"slider json", formatJSON, false, func(p *printer) error { return p.printInts(10) }, "10\n"},
+		{"map", formatKey, false, func(p *printer) error { return p.printMap(map[string]string{"b": "2", "a": "1"}) }, "a=1\nb=2\n"},
+		{"map json", formatJSON, false, func(p *printer) error { return p.printMap(map[string]string{"a": "1"}) }, `{"a":"1"}` + "\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			if err := tt.print(newPrinter(buf, tt.format, tt.null)); err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("got %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
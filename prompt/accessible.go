@@ -0,0 +1,17 @@
+package prompt
+
+import (
+	"os"
+	"strconv"
+)
+
+// AccessibleEnv is the environment variable enabling accessible mode by default, e.g. CONSOLIO_ACCESSIBLE=1.
+// (ai generated comment)
+const AccessibleEnv = "CONSOLIO_ACCESSIBLE"
+
+// accessibleFromEnv reports whether accessible mode is enabled with CONSOLIO_ACCESSIBLE.
+// (ai generated comment)
+func accessibleFromEnv() bool {
+	on, err := strconv.ParseBool(os.Getenv(AccessibleEnv))
+	return err == nil && on
+}
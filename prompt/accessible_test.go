@@ -0,0 +1,214 @@
+package prompt
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// accessibleTerminal returns line terminal options with accessible mode enabled
+func accessibleTerminal(t *testing.T, answers string) ([]PromptOption, func() string) {
+	t.Helper()
+	opts, output := lineTerminal(t, answers)
+	return append(opts, WithAccessible(true)), output
+}
+
+// testSearchItems returns items used by accessible search and select tests
+func testSearchItems() []*Item {
+	return []*Item{
+		NewItem("apple").WithGroup("fruit").WithHint("new"),
+		NewItem("pear").WithGroup("fruit").WithDisabled("out of stock"),
+		NewItem("carrot").WithGroup("vegetables").WithDescription("orange root"),
+		NewItem("apricot").WithGroup("fruit"),
+	}
+}
+
+// TestAccessibleDefault tests that accessible mode default is taken from the environment
+func TestAccessibleDefault(t *testing.T) {
+	t.Setenv(AccessibleEnv, "")
+	if newTestBuilder(t, ptSearch).getAccessible() {
+		t.Error("accessible mode should be off by default")
+	}
+	t.Setenv(AccessibleEnv, "1")
+	if !newTestBuilder(t, ptSearch).getAccessible() {
+		t.Errorf("%v=1 should enable accessible mode", AccessibleEnv)
+	}
+	if newTestBuilder(t, ptSearch, WithAccessible(false)).getAccessible() {
+		t.Error("WithAccessible(false) should override the environment")
+	}
+}
+
+// TestAccessibleSearch tests filtering, numbered list and choice of the line oriented search
+func TestAccessibleSearch(t *testing.T) {
+	opts, output := accessibleTerminal(t, "zzz\nap\n\nr\n1\n7\n2\n")
+	item, err := SearchItem(append(opts, FromItems(testSearchItems()), WithTitle("Pick:"))...)
+	if err != nil {
+		t.Fatalf("SearchItem() error = %v", err)
+	}
+	if item.Key() != "carrot" {
+		t.Errorf("SearchItem() = %v, want carrot", item.Key())
+	}
+	out := output()
+	for _, want := range []string{
+		"Pick:",
+		`No items match "zzz"`,
+		"2 of 4 items:",
+		"1. apple [new]",
+		"2. apricot",
+		"1. pear (out of stock)",
+		"2. carrot - orange root",
+		"Error: pear can not be selected: out of stock",
+		"Error: enter a number between 1 and 3",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output does not contain %q:\n%v", want, out)
+		}
+	}
+	if strings.ContainsAny(out, "\x1b┃") {
+		t.Errorf("output should be plain text:\n%q", out)
+	}
+}
+
+// TestAccessibleSearchAsync tests that asynchronous validator runs on choice
+func TestAccessibleSearchAsync(t *testing.T) {
+	opts, output := accessibleTerminal(t, "\n1\n4\n")
+	noApple := func(_ context.Context, item *Item) error {
+		if item.Key() == "apple" {
+			return errors.New("apple is taken")
+		}
+		return nil
+	}
+	item, err := SearchItem(append(opts, FromItems(testSearchItems()), WithAsyncItemValidator(noApple))...)
+	if err != nil || item.Key() != "apricot" {
+		t.Fatalf("SearchItem() = %v, %v, want apricot", item, err)
+	}
+	if !strings.Contains(output(), "Error: apple is taken") {
+		t.Errorf("output should contain async error:\n%v", output())
+	}
+}
+
+// TestAccessibleSearchValidator tests that the item validator runs on choice
+func TestAccessibleSearchValidator(t *testing.T) {
+	opts, output := accessibleTerminal(t, "\n1\n4\n")
+	noApple := func(item *Item) error {
+		if item.Key() == "apple" {
+			return errors.New("apple is taken")
+		}
+		return nil
+	}
+	item, err := SearchItem(append(opts, FromItems(testSearchItems()), WithItemValidator(noApple))...)
+	if err != nil || item.Key() != "apricot" {
+		t.Fatalf("SearchItem() = %v, %v, want apricot", item, err)
+	}
+	if !strings.Contains(output(), "Error: apple is taken") {
+		t.Errorf("output should contain validation error:\n%v", output())
+	}
+}
+
+// TestAccessibleEndOfInput tests that end of input cancels the prompt
+func TestAccessibleEndOfInput(t *testing.T) {
+	opts, _ := accessibleTerminal(t, "ap\n")
+	if _, err := SearchItem(append(opts, FromItems(testSearchItems()))...); !IsCanceled(err) {
+		t.Errorf("SearchItem() error = %v, want cancellation", err)
+	}
+}
+
+// TestAccessibleSelect tests line oriented selection of items with metadata
+func TestAccessibleSelect(t *testing.T) {
+	opts, output := accessibleTerminal(t, "2\n3\n")
+	item, err := SelectSingle(append(opts, FromItems(testSearchItems()))...)
+	if err != nil || item.Key() != "carrot" {
+		t.Fatalf("SelectSingle() = %v, %v, want carrot", item, err)
+	}
+	if out := output(); !strings.Contains(out, "fruit:\n1. apple") || !strings.Contains(out, "vegetables:\n3. carrot") {
+		t.Errorf("output should list groups:\n%v", out)
+	}
+
+	opts, _ = accessibleTerminal(t, "1, 3 1\n")
+	items, err := SelectMultiple(append(opts, FromItems(testSearchItems()))...)
+	if err != nil {
+		t.Fatalf("SelectMultiple() error = %v", err)
+	}
+	if len(items) != 2 || items[0].Key() != "apple" || items[1].Key() != "carrot" {
+		t.Errorf("SelectMultiple() = %v, want apple and carrot", items)
+	}
+}
+
+// TestAccessibleInput tests line oriented input with warnings confirmation and default value
+func TestAccessibleInput(t *testing.T) {
+	opts, output := accessibleTerminal(t, "x\n80\n80\n")
+	val, err := Input(append(opts, WithStringValidator(lowPort), WithWarningConfirmation(true))...)
+	if err != nil || val != "80" {
+		t.Fatalf("Input() = %q, %v, want 80", val, err)
+	}
+	out := output()
+	if !strings.Contains(out, "Error: ") || !strings.Contains(out, "Warning: port 80 needs root") || !strings.Contains(out, "again") {
+		t.Errorf("output should contain error, warning and confirmation request:\n%v", out)
+	}
+
+	opts, _ = accessibleTerminal(t, "\n")
+	val, err = Input(append(opts, WithDefaultValue("8080"), WithHistory("port"), WithHistoryStore(NewHistory(t.TempDir(), 0)))...)
+	if err != nil || val != "8080" {
+		t.Errorf("Input() = %q, %v, want default value", val, err)
+	}
+}
+
+// TestAccessibleInputList tests line oriented list input
+func TestAccessibleInputList(t *testing.T) {
+	opts, _ := accessibleTerminal(t, "a, b\na\nc\n\n")
+	values, err := InputList(append(opts, WithUniqueValues(true))...)
+	if err != nil {
+		t.Fatalf("InputList() error = %v", err)
+	}
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(values, want) {
+		t.Errorf("InputList() = %v, want %v", values, want)
+	}
+}
+
+// TestAccessibleEditMap tests line oriented map editing
+func TestAccessibleEditMap(t *testing.T) {
+	opts, output := accessibleTerminal(t, "-b\nnope\nc=3\n-x\na=10\n\n")
+	result, diff, err := EditMap(append(opts, FromMap(map[string]string{"a": "1", "b": "2"}))...)
+	if err != nil {
+		t.Fatalf("EditMap() error = %v", err)
+	}
+	if want := map[string]string{"a": "10", "c": "3"}; !reflect.DeepEqual(result, want) {
+		t.Errorf("EditMap() = %v, want %v", result, want)
+	}
+	if len(diff.Added) != 1 || len(diff.Removed) != 1 || len(diff.Changed) != 1 {
+		t.Errorf("EditMap() diff = %+v", diff)
+	}
+	if out := output(); !strings.Contains(out, "a=1\nb=2\n") || strings.Count(out, "Error:") != 2 {
+		t.Errorf("output should list pairs and two errors:\n%v", out)
+	}
+}
+
+// TestAccessibleSlider tests line oriented slider and range input
+func TestAccessibleSlider(t *testing.T) {
+	opts, _ := accessibleTerminal(t, "200\n15\n20\n")
+	v, err := Slider(append(opts, WithMin(0), WithMax(100), WithStep(10))...)
+	if err != nil || v != 20 {
+		t.Errorf("Slider() = %v, %v, want 20", v, err)
+	}
+
+	opts, _ = accessibleTerminal(t, "50 10\n10,50\n")
+	low, high, err := SliderRange(append(opts, WithMin(0), WithMax(100))...)
+	if err != nil || low != 10 || high != 50 {
+		t.Errorf("SliderRange() = %v, %v, %v, want 10..50", low, high, err)
+	}
+}
+
+// TestAccessibleDuration tests line oriented duration input with bounds
+func TestAccessibleDuration(t *testing.T) {
+	opts, output := accessibleTerminal(t, "soon\n5d\n90m\n")
+	d, err := InputDuration(append(opts, WithMaxDuration(24*time.Hour))...)
+	if err != nil || d != 90*time.Minute {
+		t.Errorf("InputDuration() = %v, %v, want 1h30m", d, err)
+	}
+	if strings.Count(output(), "Error:") != 2 {
+		t.Errorf("output should contain two errors:\n%v", output())
+	}
+}
@@ -0,0 +1,248 @@
+package prompt
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+)
+
+// defaultValidationDebounce is the delay between the last change and the start of asynchronous check.
+// (ai generated comment)
+const defaultValidationDebounce = 300 * time.Millisecond
+
+// AsyncStringValidatorFunc is a function type that validates string input asynchronously.
+// The context is canceled when the input changes before the check completes.
+// (ai generated comment)
+type AsyncStringValidatorFunc func(context.Context, string) error
+
+// AsyncItemValidatorFunc is a function type that validates selected items asynchronously.
+// The context is canceled when the selection changes before the check completes.
+// (ai generated comment)
+type AsyncItemValidatorFunc func(context.Context, *Item) error
+
+// asyncDebounceMsg signals that the debounce delay of a check has passed.
+// (ai generated comment)
+type asyncDebounceMsg struct {
+	owner any // Validation the message belongs to
+	seq   int // Sequence number of the check
+}
+
+// asyncResultMsg carries the result of an asynchronous check.
+// (ai generated comment)
+type asyncResultMsg struct {
+	owner any   // Validation the message belongs to
+	seq   int   // Sequence number of the check
+	err   error // Validation result
+}
+
+// asyncValidation runs debounced asynchronous checks of prompt values.
+// Only the latest check is relevant: stale checks are canceled and their results ignored.
+// A nil asyncValidation accepts every value, so models can use it unconditionally.
+// Warnings returned by the validator do not block submit.
+// (ai generated comment)
+type asyncValidation[T comparable] struct {
+	validate func(context.Context, T) error // Asynchronous validator
+	debounce time.Duration                  // Delay before check starts
+	theme    *huh.Theme                     // Visual theme for consistent styling
+
+	seq      int                // Sequence number of the latest check
+	value    T                  // Value of the latest check
+	cancel   context.CancelFunc // Cancels the running check
+	checking bool               // Whether the latest check is in progress
+	checked  bool               // Whether the latest check is completed
+	err      error              // Result of the latest completed check
+	submit   bool               // Whether submit waits for the latest check
+	spinner  spinner.Model      // Progress indicator shown while checking
+	hooks    hooks              // Callbacks notified about rejected submits
+	msgs     localizer          // Translator of built-in strings
+	instant  bool               // Whether checks start without debounce and the spinner stands still (session replay)
+}
+
+// newAsyncValidation creates an asynchronous validation for the validator.
+// Returns nil if validator is nil.
+// (ai generated comment)
+func newAsyncValidation[T comparable](validate func(context.Context, T) error, debounce time.Duration, theme *huh.Theme) *asyncValidation[T] {
+	if validate == nil {
+		return nil
+	}
+	return &asyncValidation[T]{
+		validate: validate,
+		debounce: debounce,
+		theme:    theme,
+		spinner:  newCheckSpinner(theme),
+	}
+}
+
+// newCheckSpinner creates the progress indicator of a check.
+// (ai generated comment)
+func newCheckSpinner(theme *huh.Theme) spinner.Model {
+	return spinner.New(spinner.WithSpinner(spinner.MiniDot), spinner.WithStyle(theme.Focused.Description))
+}
+
+// replayMode returns a copy of the validation that never waits for wall-clock time,
+// so replayed sessions resolve the same way on every run.
+// (ai generated comment)
+func (av *asyncValidation[T]) replayMode() *asyncValidation[T] {
+	if av == nil {
+		return nil
+	}
+	c := *av
+	c.instant = true
+	return &c
+}
+
+// change schedules a debounced check of the value and cancels the running one.
+// Does nothing if the value is already being checked or was checked.
+// (ai generated comment)
+func (av *asyncValidation[T]) change(value T) tea.Cmd {
+	if av == nil || ((av.checking || av.checked) && av.value == value) {
+		return nil
+	}
+	seq := av.restart(value)
+	if av.debounce <= 0 || av.instant {
+		return tea.Batch(av.spinner.Tick, av.run())
+	}
+	return tea.Batch(av.spinner.Tick, tea.Tick(av.debounce, func(time.Time) tea.Msg {
+		return asyncDebounceMsg{owner: av, seq: seq}
+	}))
+}
+
+// requestSubmit reports whether the value passed the latest check.
+// Otherwise marks submit as pending until the check of the value completes
+// and returns the command starting the check if needed.
+// (ai generated comment)
+func (av *asyncValidation[T]) requestSubmit(value T) (bool, tea.Cmd) {
+	if av == nil {
+		return true, nil
+	}
+	if av.value == value && av.checked {
+		return !isBlocking(av.err), nil
+	}
+	var cmd tea.Cmd
+	if !av.checking || av.value != value {
+		av.restart(value)
+		cmd = tea.Batch(av.spinner.Tick, av.run())
+	}
+	av.submit = true
+	return false, cmd
+}
+
+// withHooks sets callbacks notified when the check of a value waiting for submit fails.
+// (ai generated comment)
+func (av *asyncValidation[T]) withHooks(h hooks) *asyncValidation[T] {
+	if av != nil {
+		av.hooks = h
+	}
+	return av
+}
+
+// withMessages sets the translator of the progress text.
+// (ai generated comment)
+func (av *asyncValidation[T]) withMessages(msgs localizer) *asyncValidation[T] {
+	if av != nil {
+		av.msgs = msgs
+	}
+	return av
+}
+
+// restart cancels the running check and prepares a new one for the value.
+// The spinner starts from its first frame. Returns the sequence number of the new check.
+// (ai generated comment)
+func (av *asyncValidation[T]) restart(value T) int {
+	av.stop()
+	av.seq++
+	av.value = value
+	av.checking = true
+	av.checked = false
+	av.err = nil
+	av.submit = false
+	av.spinner = newCheckSpinner(av.theme)
+	return av.seq
+}
+
+// run starts the latest check.
+// (ai generated comment)
+func (av *asyncValidation[T]) run() tea.Cmd {
+	ctx, cancel := context.WithCancel(context.Background())
+	av.cancel = cancel
+	validate, value, seq := av.validate, av.value, av.seq
+	return func() tea.Msg {
+		return asyncResultMsg{owner: av, seq: seq, err: validate(ctx, value)}
+	}
+}
+
+// stop cancels the running check.
+// (ai generated comment)
+func (av *asyncValidation[T]) stop() {
+	if av != nil && av.cancel != nil {
+		av.cancel()
+		av.cancel = nil
+	}
+}
+
+// update handles messages of the validation.
+// Returns whether the message was handled, whether pending submit can proceed
+// and the command to execute.
+// (ai generated comment)
+func (av *asyncValidation[T]) update(msg tea.Msg) (bool, bool, tea.Cmd) {
+	if av == nil {
+		return false, false, nil
+	}
+	switch msg := msg.(type) {
+	case asyncDebounceMsg:
+		if msg.owner != any(av) {
+			return false, false, nil
+		}
+		if msg.seq != av.seq || !av.checking {
+			return true, false, nil
+		}
+		return true, false, av.run()
+	case asyncResultMsg:
+		if msg.owner != any(av) {
+			return false, false, nil
+		}
+		if msg.seq != av.seq {
+			return true, false, nil
+		}
+		av.stop()
+		av.checking = false
+		av.checked = true
+		av.err = msg.err
+		if av.submit {
+			av.hooks.invalid(av.value, msg.err)
+		}
+		submit := av.submit && !isBlocking(msg.err)
+		av.submit = false
+		return true, submit, nil
+	case spinner.TickMsg:
+		if msg.ID != av.spinner.ID() {
+			return false, false, nil
+		}
+		if !av.checking || av.instant {
+			return true, false, nil
+		}
+		var cmd tea.Cmd
+		av.spinner, cmd = av.spinner.Update(msg)
+		return true, false, cmd
+	}
+	return false, false, nil
+}
+
+// view renders the progress of the running check or the error or warning of the completed one.
+// (ai generated comment)
+func (av *asyncValidation[T]) view() string {
+	switch {
+	case av == nil:
+		return ""
+	case av.checking:
+		return startLine(av.theme) + av.spinner.View() + av.theme.Focused.Description.Render(" "+av.msgs.t("checking…"))
+	case av.checked && IsWarning(av.err):
+		return startLine(av.theme) + warningStyle(av.theme).Render("⚠ "+av.err.Error())
+	case av.checked && av.err != nil:
+		return startLine(av.theme) + av.theme.Focused.ErrorMessage.Render(av.err.Error())
+	}
+	return ""
+}
@@ -0,0 +1,169 @@
+package prompt
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+)
+
+// execute runs the command and returns its message, or nil if it does not finish in time
+// (cursor blink commands wait until canceled).
+func execute(cmd tea.Cmd) tea.Msg {
+	result := make(chan tea.Msg, 1)
+	go func() { result <- cmd() }()
+	select {
+	case msg := <-result:
+		return msg
+	case <-time.After(100 * time.Millisecond):
+		return nil
+	}
+}
+
+// drain executes the command and feeds resulting messages back to the model until it settles.
+// Only validation messages are delivered, so animations do not loop endlessly.
+func drain(m tea.Model, cmd tea.Cmd) tea.Model {
+	queue := []tea.Cmd{cmd}
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		if next == nil {
+			continue
+		}
+		switch msg := execute(next).(type) {
+		case tea.BatchMsg:
+			queue = append(queue, msg...)
+		case asyncDebounceMsg, asyncResultMsg:
+			var c tea.Cmd
+			m, c = m.Update(msg)
+			queue = append(queue, c)
+		}
+	}
+	return m
+}
+
+// press sends a key to the model and drains resulting commands.
+func press(m tea.Model, k tea.KeyType) tea.Model {
+	m, cmd := m.Update(tea.KeyMsg{Type: k})
+	return drain(m, cmd)
+}
+
+// typeAndDrain types text into the model and drains resulting commands after each rune.
+func typeAndDrain(m tea.Model, text string) tea.Model {
+	for _, r := range text {
+		var cmd tea.Cmd
+		m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		m = drain(m, cmd)
+	}
+	return m
+}
+
+// notTaken is an asynchronous validator rejecting the value "taken"
+func notTaken(ctx context.Context, s string) error {
+	if s == "taken" {
+		return errors.New("name is taken")
+	}
+	return ctx.Err()
+}
+
+// TestAsyncValidationStaleChecks tests that a new change cancels and ignores the previous check
+func TestAsyncValidationStaleChecks(t *testing.T) {
+	var contexts []context.Context
+	av := newAsyncValidation(func(ctx context.Context, s string) error {
+		contexts = append(contexts, ctx)
+		return nil
+	}, 0, huh.ThemeBase16())
+	first := av.change("a")
+	av.change("b")
+	if av.value != "b" || !av.checking {
+		t.Fatalf("latest check = %q (checking %v), want b", av.value, av.checking)
+	}
+	var stale tea.Msg
+	for _, cmd := range first().(tea.BatchMsg) {
+		if msg, ok := cmd().(asyncResultMsg); ok {
+			stale = msg
+		}
+	}
+	if contexts[0].Err() == nil {
+		t.Error("stale check context should be canceled")
+	}
+	handled, submit, _ := av.update(stale)
+	if !handled || submit || av.checked {
+		t.Error("stale result should be ignored")
+	}
+	if av.change("b") != nil {
+		t.Error("change to the value being checked should not restart the check")
+	}
+}
+
+// TestAsyncValidationNil tests that nil validation accepts everything
+func TestAsyncValidationNil(t *testing.T) {
+	av := newAsyncValidation[string](nil, 0, huh.ThemeBase16())
+	if av != nil {
+		t.Fatal("expected nil validation for nil validator")
+	}
+	if ready, cmd := av.requestSubmit("x"); !ready || cmd != nil {
+		t.Error("nil validation should accept submit immediately")
+	}
+	if av.change("x") != nil || av.view() != "" {
+		t.Error("nil validation should do nothing")
+	}
+}
+
+// TestInputModelAsyncValidation tests that submit is blocked until the latest check passes
+func TestInputModelAsyncValidation(t *testing.T) {
+	var m tea.Model = newTestInputModel(WithAsyncValidator(notTaken), WithValidationDebounce(0))
+	m = typeAndDrain(m, "taken")
+	m = press(m, tea.KeyEnter)
+	im := m.(inputModel)
+	if im.done {
+		t.Fatal("input with failed async check should not be submitted")
+	}
+	if im.async.err == nil {
+		t.Error("async error should be kept for rendering")
+	}
+	m = typeAndDrain(m, "2")
+	m = press(m, tea.KeyEnter)
+	if im := m.(inputModel); !im.done || im.value != "taken2" {
+		t.Errorf("value = %q (done %v), want taken2", im.value, im.done)
+	}
+}
+
+// TestInputModelAsyncPendingSubmit tests that enter during a running check submits after it passes
+func TestInputModelAsyncPendingSubmit(t *testing.T) {
+	var m tea.Model = newTestInputModel(WithAsyncValidator(notTaken), WithValidationDebounce(0))
+	m, check := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("ok")})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.(inputModel).done || !m.(inputModel).async.submit {
+		t.Fatal("submit should wait for running check")
+	}
+	m = drain(m, check)
+	if im := m.(inputModel); !im.done || im.value != "ok" {
+		t.Errorf("value = %q (done %v), want ok", im.value, im.done)
+	}
+}
+
+// TestSelectModelAsyncValidation tests asynchronous check of the highlighted item
+func TestSelectModelAsyncValidation(t *testing.T) {
+	notApple := func(ctx context.Context, item *Item) error {
+		if item.Key() == "apple" {
+			return errors.New("apple is sold out")
+		}
+		return nil
+	}
+	var m tea.Model = newTestSelectModel(t, ptSelect, WithAsyncItemValidator(notApple), WithValidationDebounce(0))
+	m = drain(m, m.Init())
+	m = press(m, tea.KeyEnter)
+	if m.(selectModel).done {
+		t.Fatal("item with failed async check should not be submitted")
+	}
+	m = press(m, tea.KeyDown)
+	m = press(m, tea.KeyDown)
+	m = press(m, tea.KeyEnter)
+	if sm := m.(selectModel); !sm.done || sm.selected[0].Key() != "carrot" {
+		t.Errorf("selected = %v (done %v), want carrot", sm.selected, sm.done)
+	}
+}
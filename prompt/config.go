@@ -0,0 +1,461 @@
+package prompt
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/huh"
+)
+
+// configOption describes an option that can be set from defaults files and environment.
+// (ai generated comment)
+type configOption struct {
+	key     any                        // Typed option key
+	convert func(raw any) (any, error) // Converts a config value to the option type
+}
+
+// configurable creates a config option converting values with the typed converter.
+// (ai generated comment)
+func configurable[T OptionType](key OptionKey[T], convert func(raw any) (T, error)) configOption {
+	return configOption{
+		key: key,
+		convert: func(raw any) (any, error) {
+			return convert(raw)
+		},
+	}
+}
+
+// configOptions maps option names used in defaults files to their keys and converters.
+// Options holding data (items, pairs source) or runtime objects (terminals, callbacks) are not configurable.
+// (ai generated comment)
+var configOptions = map[string]configOption{
+	string(KeyTitle):               configurable(KeyTitle, configString),
+	string(KeyDescription):         configurable(KeyDescription, configString),
+	string(KeyPrompt):              configurable(KeyPrompt, configString),
+	string(KeyPlaceholder):         configurable(KeyPlaceholder, configString),
+	string(KeyAffirmative):         configurable(KeyAffirmative, configString),
+	string(KeyNegative):            configurable(KeyNegative, configString),
+	string(KeyWidth):               configurable(KeyWidth, configInt),
+	string(KeyHeight):              configurable(KeyHeight, configInt),
+	string(KeyTheme):               configurable(KeyTheme, configTheme),
+	string(KeyCaseSensitiveFilter): configurable(KeyCaseSensitiveFilter, configBool),
+	string(KeySearchDescriptions):  configurable(KeySearchDescriptions, configBool),
+	string(KeyStringValidatorFunc): configurable(KeyStringValidatorFunc, configValidator),
+	string(KeyKeyValidatorFunc):    configurable(KeyKeyValidatorFunc, configValidator),
+	string(KeyValueValidatorFunc):  configurable(KeyValueValidatorFunc, configValidator),
+	string(KeyLayout):              configurable(KeyLayout, configString),
+	string(KeyLocation):            configurable(KeyLocation, configLocation),
+	string(KeyMinTime):             configurable(KeyMinTime, configTime),
+	string(KeyMaxTime):             configurable(KeyMaxTime, configTime),
+	string(KeyMinDuration):         configurable(KeyMinDuration, configDuration),
+	string(KeyMaxDuration):         configurable(KeyMaxDuration, configDuration),
+	string(KeyUniqueValues):        configurable(KeyUniqueValues, configBool),
+	string(KeyPairs):               configurable(KeyPairs, configStringMap),
+	string(KeyMin):                 configurable(KeyMin, configInt),
+	string(KeyMax):                 configurable(KeyMax, configInt),
+	string(KeyStep):                configurable(KeyStep, configInt),
+	string(KeyCoarseStep):          configurable(KeyCoarseStep, configInt),
+	string(KeyTickLabels):          configurable(KeyTickLabels, configTickLabels),
+	string(KeyDefaultValue):        configurable(KeyDefaultValue, configString),
+	string(KeyDefaultConfirm):      configurable(KeyDefaultConfirm, configBool),
+	string(KeyDefaultValues):       configurable(KeyDefaultValues, configStrings),
+	string(KeyDefaultNumbers):      configurable(KeyDefaultNumbers, configInts),
+	string(KeyConfirmWarnings):     configurable(KeyConfirmWarnings, configBool),
+	string(KeyValidationDebounce):  configurable(KeyValidationDebounce, configDuration),
+	string(KeyHistoryID):           configurable(KeyHistoryID, configString),
+	string(KeyAccessible):          configurable(KeyAccessible, configBool),
+	string(KeyLanguage):            configurable(KeyLanguage, configString),
+	string(KeyRecordPath):          configurable(KeyRecordPath, configString),
+}
+
+// lookupConfigOption returns the config option with the name.
+// (ai generated comment)
+func lookupConfigOption(name string) (configOption, error) {
+	option, ok := configOptions[name]
+	if !ok {
+		return configOption{}, fmt.Errorf("unknown option %q", name)
+	}
+	return option, nil
+}
+
+// allPromptTypes is the section name of defaults applied to every prompt type.
+// (ai generated comment)
+const allPromptTypes = "all"
+
+// configPromptTypes resolves a section name of a defaults file to prompt types.
+// (ai generated comment)
+func configPromptTypes(section string) ([]promptType, error) {
+	if section == allPromptTypes {
+		return promptTypes, nil
+	}
+	if pt := promptType(section); slices.Contains(promptTypes, pt) {
+		return []promptType{pt}, nil
+	}
+	return nil, fmt.Errorf("unknown prompt type %q", section)
+}
+
+// configString converts a scalar config value to string.
+// (ai generated comment)
+func configString(raw any) (string, error) {
+	switch v := raw.(type) {
+	case string:
+		return v, nil
+	case bool, int, int64, float64, json.Number:
+		return fmt.Sprint(v), nil
+	}
+	return "", fmt.Errorf("expected string, got %T", raw)
+}
+
+// configInt converts a number or numeric string to int.
+// (ai generated comment)
+func configInt(raw any) (int, error) {
+	switch v := raw.(type) {
+	case int:
+		return v, nil
+	case int64:
+		return int(v), nil
+	case float64:
+		if v != math.Trunc(v) {
+			return 0, fmt.Errorf("expected integer, got %v", v)
+		}
+		return int(v), nil
+	case json.Number:
+		return strconv.Atoi(v.String())
+	case string:
+		return strconv.Atoi(strings.TrimSpace(v))
+	}
+	return 0, fmt.Errorf("expected integer, got %T", raw)
+}
+
+// configBool converts a boolean or boolean string to bool.
+// (ai generated comment)
+func configBool(raw any) (bool, error) {
+	switch v := raw.(type) {
+	case bool:
+		return v, nil
+	case string:
+		return strconv.ParseBool(strings.TrimSpace(v))
+	}
+	return false, fmt.Errorf("expected boolean, got %T", raw)
+}
+
+// configDuration converts a duration string like "1h30m" to time.Duration.
+// (ai generated comment)
+func configDuration(raw any) (time.Duration, error) {
+	s, err := configString(raw)
+	if err != nil {
+		return 0, err
+	}
+	return time.ParseDuration(strings.TrimSpace(s))
+}
+
+// configTime converts an RFC 3339 timestamp or a date to time.Time.
+// (ai generated comment)
+func configTime(raw any) (time.Time, error) {
+	s, err := configString(raw)
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, layout := range []string{time.RFC3339, time.DateTime, time.DateOnly} {
+		if t, err := time.Parse(layout, strings.TrimSpace(s)); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("expected RFC 3339 time or date, got %q", s)
+}
+
+// configLocation converts a time zone name to *time.Location.
+// (ai generated comment)
+func configLocation(raw any) (*time.Location, error) {
+	s, err := configString(raw)
+	if err != nil {
+		return nil, err
+	}
+	return time.LoadLocation(strings.TrimSpace(s))
+}
+
+// configList converts a list or a comma separated string to list of config values.
+// (ai generated comment)
+func configList(raw any) ([]any, error) {
+	switch v := raw.(type) {
+	case []any:
+		return v, nil
+	case string:
+		list := []any{}
+		for _, part := range splitConfigList(v) {
+			list = append(list, part)
+		}
+		return list, nil
+	}
+	return nil, fmt.Errorf("expected list, got %T", raw)
+}
+
+// splitConfigList splits a list written as a string on top-level commas and newlines.
+// Commas inside brackets, braces, parentheses or double quotes do not split, so arguments
+// like "regexp:^a{1,3}$" stay whole. Parts quoted as a whole are unquoted and empty parts are dropped.
+// (ai generated comment)
+func splitConfigList(text string) []string {
+	parts := []string{}
+	depth, start, quoted := 0, 0, false
+	for i := 0; i < len(text); i++ {
+		switch c := text[i]; {
+		case quoted && c == '\\':
+			i++
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == '[' || c == '{' || c == '(':
+			depth++
+		case (c == ']' || c == '}' || c == ')') && depth > 0:
+			depth--
+		case (c == ',' || c == '\n' || c == '\r') && depth == 0:
+			parts = append(parts, text[start:i])
+			start = i + 1
+		}
+	}
+	parts = append(parts, text[start:])
+	values := []string{}
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if unquoted, err := strconv.Unquote(part); err == nil && strings.HasPrefix(part, `"`) {
+			part = unquoted
+		}
+		if part != "" {
+			values = append(values, part)
+		}
+	}
+	return values
+}
+
+// configStrings converts a list config value to []string.
+// (ai generated comment)
+func configStrings(raw any) ([]string, error) {
+	list, err := configList(raw)
+	if err != nil {
+		return nil, err
+	}
+	values := []string{}
+	for _, item := range list {
+		s, err := configString(item)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, s)
+	}
+	return values, nil
+}
+
+// configInts converts a list config value to []int.
+// (ai generated comment)
+func configInts(raw any) ([]int, error) {
+	list, err := configList(raw)
+	if err != nil {
+		return nil, err
+	}
+	values := []int{}
+	for _, item := range list {
+		n, err := configInt(item)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, n)
+	}
+	return values, nil
+}
+
+// configMap converts a mapping or a "k=v,k2=v2" string to a mapping of config values.
+// (ai generated comment)
+func configMap(raw any) (map[string]any, error) {
+	switch v := raw.(type) {
+	case map[string]any:
+		return v, nil
+	case string:
+		mapping := map[string]any{}
+		for _, pair := range splitConfigList(v) {
+			key, value, ok := strings.Cut(pair, "=")
+			if !ok {
+				return nil, fmt.Errorf("expected key=value, got %q", pair)
+			}
+			mapping[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+		return mapping, nil
+	}
+	return nil, fmt.Errorf("expected mapping, got %T", raw)
+}
+
+// configStringMap converts a mapping config value to map[string]string.
+// (ai generated comment)
+func configStringMap(raw any) (map[string]string, error) {
+	mapping, err := configMap(raw)
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]string{}
+	for key, item := range mapping {
+		if values[key], err = configString(item); err != nil {
+			return nil, fmt.Errorf("%v: %v", key, err)
+		}
+	}
+	return values, nil
+}
+
+// configTickLabels converts a mapping of numbers to labels to map[int]string.
+// (ai generated comment)
+func configTickLabels(raw any) (map[int]string, error) {
+	mapping, err := configStringMap(raw)
+	if err != nil {
+		return nil, err
+	}
+	labels := map[int]string{}
+	for key, label := range mapping {
+		n, err := strconv.Atoi(key)
+		if err != nil {
+			return nil, fmt.Errorf("tick %q is not a number", key)
+		}
+		labels[n] = label
+	}
+	return labels, nil
+}
+
+// configTheme converts a theme name or theme file path to *huh.Theme.
+// (ai generated comment)
+func configTheme(raw any) (*huh.Theme, error) {
+	name, err := configString(raw)
+	if err != nil {
+		return nil, err
+	}
+	return ResolveTheme(name)
+}
+
+// configValidator converts a validator name, a comma separated string or a list of names to a validator.
+// A list combines validators with All. Names may take an argument after colon,
+// e.g. "min_length:3", "regexp:^[a-z]+$", "int_range:1..10", "one_of:a|b|c".
+// (ai generated comment)
+func configValidator(raw any) (StringValidatorFunc, error) {
+	names, err := configStrings(raw)
+	if err != nil {
+		return nil, err
+	}
+	validators := []func(string) error{}
+	for _, name := range names {
+		validator, err := ValidatorByName(name)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, validator)
+	}
+	switch len(validators) {
+	case 0:
+		return defaultStringValidatorFunc, nil
+	case 1:
+		return validators[0], nil
+	}
+	return All(validators...), nil
+}
+
+var (
+	namedValidators   = map[string]StringValidatorFunc{}
+	namedValidatorsMu sync.RWMutex
+)
+
+// RegisterValidator registers a string validator under a name usable in defaults files.
+// Registered names take precedence over built-in ones.
+// (ai generated comment)
+func RegisterValidator(name string, validator StringValidatorFunc) {
+	namedValidatorsMu.Lock()
+	defer namedValidatorsMu.Unlock()
+	namedValidators[name] = validator
+}
+
+// ValidatorByName returns a registered or built-in string validator by its name.
+// Built-in names are non_empty, integer, float, duration, email, url, hostname, ip, cidr,
+// port, semver, json, existing_file, existing_dir and, with argument after colon,
+// min_length, max_length, regexp, int_range, float_range, one_of and time_format.
+// (ai generated comment)
+func ValidatorByName(name string) (StringValidatorFunc, error) {
+	name = strings.TrimSpace(name)
+	namedValidatorsMu.RLock()
+	validator, ok := namedValidators[name]
+	namedValidatorsMu.RUnlock()
+	if ok {
+		return validator, nil
+	}
+	base, arg, hasArg := strings.Cut(name, ":")
+	if !hasArg {
+		switch base {
+		case "non_empty":
+			return NonEmpty, nil
+		case "integer":
+			return Integer, nil
+		case "float":
+			return Float64, nil
+		case "duration":
+			return Duration, nil
+		case "email":
+			return Email, nil
+		case "url":
+			return URL, nil
+		case "hostname":
+			return Hostname, nil
+		case "ip":
+			return IP, nil
+		case "cidr":
+			return CIDR, nil
+		case "port":
+			return Port, nil
+		case "semver":
+			return Semver, nil
+		case "json":
+			return JSON, nil
+		case "existing_file":
+			return ExistingFile, nil
+		case "existing_dir":
+			return ExistingDir, nil
+		}
+		return nil, fmt.Errorf("unknown validator %q", name)
+	}
+	switch base {
+	case "min_length", "max_length":
+		n, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, fmt.Errorf("validator %v: bad length %q", base, arg)
+		}
+		if base == "min_length" {
+			return MinLength(n), nil
+		}
+		return MaxLength(n), nil
+	case "regexp":
+		if _, err := regexp.Compile(arg); err != nil {
+			return nil, fmt.Errorf("validator %v: %v", base, err)
+		}
+		return Regexp(arg), nil
+	case "int_range":
+		low, high, ok := strings.Cut(arg, "..")
+		minValue, errMin := strconv.Atoi(low)
+		maxValue, errMax := strconv.Atoi(high)
+		if !ok || errMin != nil || errMax != nil {
+			return nil, fmt.Errorf("validator %v: expected range like 1..10, got %q", base, arg)
+		}
+		return IntRange(minValue, maxValue), nil
+	case "float_range":
+		low, high, ok := strings.Cut(arg, "..")
+		minValue, errMin := strconv.ParseFloat(low, 64)
+		maxValue, errMax := strconv.ParseFloat(high, 64)
+		if !ok || errMin != nil || errMax != nil {
+			return nil, fmt.Errorf("validator %v: expected range like 0.5..1.5, got %q", base, arg)
+		}
+		return FloatRange(minValue, maxValue), nil
+	case "one_of":
+		return OneOf(strings.Split(arg, "|")...), nil
+	case "time_format":
+		return TimeFormat(arg), nil
+	}
+	return nil, fmt.Errorf("unknown validator %q", name)
+}
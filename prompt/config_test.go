@@ -0,0 +1,95 @@
+package prompt
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestConfigConverters tests conversion of config values to option types
+func TestConfigConverters(t *testing.T) {
+	if n, err := configInt("42"); err != nil || n != 42 {
+		t.Errorf("configInt(\"42\") = %v, %v", n, err)
+	}
+	if _, err := configInt(1.5); err == nil {
+		t.Error("configInt(1.5) should fail")
+	}
+	if b, err := configBool("true"); err != nil || !b {
+		t.Errorf("configBool(\"true\") = %v, %v", b, err)
+	}
+	if d, err := configDuration("1m30s"); err != nil || d != 90*time.Second {
+		t.Errorf("configDuration() = %v, %v", d, err)
+	}
+	if tm, err := configTime("2024-02-29"); err != nil || tm.Day() != 29 {
+		t.Errorf("configTime() = %v, %v", tm, err)
+	}
+	if got, err := configStrings("a, b,,c"); err != nil || !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("configStrings() = %v, %v", got, err)
+	}
+	if got, err := configInts([]any{int64(1), "2"}); err != nil || !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("configInts() = %v, %v", got, err)
+	}
+	if got, err := configStringMap("A=1,B=2"); err != nil || !reflect.DeepEqual(got, map[string]string{"A": "1", "B": "2"}) {
+		t.Errorf("configStringMap() = %v, %v", got, err)
+	}
+	if got, err := configTickLabels(map[string]any{"10": "low"}); err != nil || got[10] != "low" {
+		t.Errorf("configTickLabels() = %v, %v", got, err)
+	}
+	if theme, err := configTheme("Dracula"); err != nil || theme == nil {
+		t.Errorf("configTheme() = %v, %v", theme, err)
+	}
+	if _, err := configTheme("neon"); err == nil {
+		t.Error("configTheme() should reject unknown theme")
+	}
+}
+
+// TestValidatorByName tests built-in, parametrized and registered validator names
+func TestValidatorByName(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid string
+		bad   string
+	}{
+		{"integer", "12", "x"},
+		{"min_length:3", "abc", "ab"},
+		{"regexp:^[a-z]+$", "abc", "ABC"},
+		{"int_range:1..10", "10", "11"},
+		{"one_of:red|green", "red", "blue"},
+	}
+	for _, tt := range tests {
+		validator, err := ValidatorByName(tt.name)
+		if err != nil {
+			t.Errorf("ValidatorByName(%v) error = %v", tt.name, err)
+			continue
+		}
+		if validator(tt.valid) != nil || validator(tt.bad) == nil {
+			t.Errorf("validator %v accepts %q or rejects %q", tt.name, tt.bad, tt.valid)
+		}
+	}
+	for _, name := range []string{"unknown", "regexp:[", "int_range:1", "min_length:x"} {
+		if _, err := ValidatorByName(name); err == nil {
+			t.Errorf("ValidatorByName(%v) should fail", name)
+		}
+	}
+	RegisterValidator("test_even", IntRange(2, 2))
+	if validator, err := ValidatorByName("test_even"); err != nil || validator("2") != nil {
+		t.Errorf("registered validator = %v", err)
+	}
+	combined, err := configValidator([]any{"integer", "max_length:2"})
+	if err != nil || combined("12") != nil || combined("123") == nil || combined("ab") == nil {
+		t.Errorf("configValidator() should combine validators, error = %v", err)
+	}
+}
+
+// TestConfigPromptTypes tests resolution of section names
+func TestConfigPromptTypes(t *testing.T) {
+	if types, err := configPromptTypes("all"); err != nil || len(types) != len(promptTypes) {
+		t.Errorf("configPromptTypes(all) = %v, %v", types, err)
+	}
+	if types, err := configPromptTypes("select_multi"); err != nil || types[0] != ptSelectMulti {
+		t.Errorf("configPromptTypes(select_multi) = %v, %v", types, err)
+	}
+	if _, err := configPromptTypes("dialog"); err == nil {
+		t.Error("configPromptTypes() should reject unknown section")
+	}
+}
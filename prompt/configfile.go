@@ -0,0 +1,314 @@
+package prompt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// configFormat identifies the encoding of a defaults file.
+// (ai generated comment)
+type configFormat string
+
+const (
+	formatJSON configFormat = "json" // JSON document
+	formatYAML configFormat = "yaml" // YAML 1.2 document
+	formatTOML configFormat = "toml" // TOML 1.0 document
+)
+
+// formatOf detects the format of a defaults file from its extension.
+// (ai generated comment)
+func formatOf(path string) (configFormat, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return formatJSON, nil
+	case ".yaml", ".yml":
+		return formatYAML, nil
+	case ".toml":
+		return formatTOML, nil
+	}
+	return "", fmt.Errorf("unsupported defaults file format %q", filepath.Ext(path))
+}
+
+// decodeConfig parses a defaults document into nested maps.
+// Scalars are decoded as string, bool, int64 or float64 (json.Number for JSON).
+// (ai generated comment)
+func decodeConfig(format configFormat, data []byte) (map[string]any, error) {
+	switch format {
+	case formatJSON:
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		doc := map[string]any{}
+		if err := decoder.Decode(&doc); err != nil {
+			return nil, err
+		}
+		return doc, nil
+	case formatYAML:
+		return decodeYAML(string(data))
+	case formatTOML:
+		return decodeTOML(string(data))
+	}
+	return nil, fmt.Errorf("unsupported format %v", format)
+}
+
+// encodeConfig serializes nested maps into a defaults document with sorted keys.
+// (ai generated comment)
+func encodeConfig(format configFormat, doc map[string]any) ([]byte, error) {
+	switch format {
+	case formatJSON:
+		data, err := json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	case formatYAML:
+		b := &strings.Builder{}
+		encodeYAML(b, doc, 0)
+		return []byte(b.String()), nil
+	case formatTOML:
+		return []byte(encodeTOML(doc)), nil
+	}
+	return nil, fmt.Errorf("unsupported format %v", format)
+}
+
+// decodeYAML parses a YAML document with gopkg.in/yaml.v3 and normalizes its values.
+// (ai generated comment)
+func decodeYAML(text string) (map[string]any, error) {
+	var doc any
+	if err := yaml.Unmarshal([]byte(text), &doc); err != nil {
+		return nil, err
+	}
+	return configDocument(doc)
+}
+
+// decodeTOML parses a TOML document with github.com/BurntSushi/toml and normalizes its values.
+// (ai generated comment)
+func decodeTOML(text string) (map[string]any, error) {
+	doc := map[string]any{}
+	if _, err := toml.Decode(text, &doc); err != nil {
+		return nil, err
+	}
+	return configDocument(doc)
+}
+
+// configDocument normalizes a decoded document and checks that it is a mapping.
+// An empty document is an empty mapping.
+// (ai generated comment)
+func configDocument(raw any) (map[string]any, error) {
+	if raw == nil {
+		return map[string]any{}, nil
+	}
+	value, err := normalizeConfig(raw)
+	if err != nil {
+		return nil, err
+	}
+	doc, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("document must be a mapping")
+	}
+	return doc, nil
+}
+
+// normalizeConfig converts decoded values to the types of the JSON decoder:
+// mappings with string keys, []any lists, int64 integers and RFC 3339 strings for timestamps.
+// (ai generated comment)
+func normalizeConfig(raw any) (any, error) {
+	switch v := raw.(type) {
+	case map[string]any:
+		mapping := map[string]any{}
+		for key, value := range v {
+			normalized, err := normalizeConfig(value)
+			if err != nil {
+				return nil, fmt.Errorf("%v: %v", key, err)
+			}
+			mapping[key] = normalized
+		}
+		return mapping, nil
+	case map[any]any:
+		mapping := map[string]any{}
+		for key, value := range v {
+			normalized, err := normalizeConfig(value)
+			if err != nil {
+				return nil, fmt.Errorf("%v: %v", key, err)
+			}
+			mapping[fmt.Sprint(key)] = normalized
+		}
+		return mapping, nil
+	case []map[string]any:
+		list := []any{}
+		for _, item := range v {
+			list = append(list, item)
+		}
+		return normalizeConfig(list)
+	case []any:
+		list := []any{}
+		for i, item := range v {
+			normalized, err := normalizeConfig(item)
+			if err != nil {
+				return nil, fmt.Errorf("item %v: %v", i, err)
+			}
+			list = append(list, normalized)
+		}
+		return list, nil
+	case int:
+		return int64(v), nil
+	case uint64:
+		if v > math.MaxInt64 {
+			return nil, fmt.Errorf("integer %v is too large", v)
+		}
+		return int64(v), nil
+	case time.Time:
+		return formatConfigTime(v), nil
+	case nil, string, bool, int64, float64:
+		return v, nil
+	}
+	return nil, fmt.Errorf("unsupported value of type %T", raw)
+}
+
+// formatConfigTime formats a decoded timestamp as RFC 3339 time.
+// TOML local dates, times and date-times are formatted without offset.
+// (ai generated comment)
+func formatConfigTime(t time.Time) string {
+	switch t.Location().String() {
+	case "date-local":
+		return t.Format(time.DateOnly)
+	case "datetime-local":
+		return t.Format(time.DateTime)
+	case "time-local":
+		return t.Format(time.TimeOnly)
+	}
+	return t.Format(time.RFC3339)
+}
+
+// sortedKeys returns keys of the mapping in sorted order.
+// (ai generated comment)
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// encodeYAML writes a mapping as a YAML block with the given indentation.
+// (ai generated comment)
+func encodeYAML(b *strings.Builder, doc map[string]any, indent int) {
+	pad := strings.Repeat(" ", indent)
+	for _, key := range sortedKeys(doc) {
+		switch value := doc[key].(type) {
+		case map[string]any:
+			fmt.Fprintf(b, "%v%v:\n", pad, quoteKey(key))
+			encodeYAML(b, value, indent+2)
+		case []any:
+			fmt.Fprintf(b, "%v%v:\n", pad, quoteKey(key))
+			for _, item := range value {
+				fmt.Fprintf(b, "%v  - %v\n", pad, formatScalar(item, quoteString))
+			}
+		default:
+			fmt.Fprintf(b, "%v%v: %v\n", pad, quoteKey(key), formatScalar(value, quoteString))
+		}
+	}
+}
+
+// encodeTOML writes top level mappings as tables and nested mappings as inline tables.
+// (ai generated comment)
+func encodeTOML(doc map[string]any) string {
+	b := &strings.Builder{}
+	tables := []string{}
+	for _, key := range sortedKeys(doc) {
+		if _, ok := doc[key].(map[string]any); ok {
+			tables = append(tables, key)
+			continue
+		}
+		fmt.Fprintf(b, "%v = %v\n", quoteKey(key), formatTOMLValue(doc[key]))
+	}
+	for _, name := range tables {
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(b, "[%v]\n", quoteKey(name))
+		table := doc[name].(map[string]any)
+		for _, key := range sortedKeys(table) {
+			fmt.Fprintf(b, "%v = %v\n", quoteKey(key), formatTOMLValue(table[key]))
+		}
+	}
+	return b.String()
+}
+
+// formatTOMLValue formats a value as inline TOML.
+// (ai generated comment)
+func formatTOMLValue(value any) string {
+	switch value := value.(type) {
+	case map[string]any:
+		parts := []string{}
+		for _, key := range sortedKeys(value) {
+			parts = append(parts, quoteKey(key)+" = "+formatTOMLValue(value[key]))
+		}
+		return "{ " + strings.Join(parts, ", ") + " }"
+	case []any:
+		parts := []string{}
+		for _, item := range value {
+			parts = append(parts, formatTOMLValue(item))
+		}
+		return "[" + strings.Join(parts, ", ") + "]"
+	}
+	return formatScalar(value, quoteString)
+}
+
+// formatScalar formats a scalar value, quoting strings with the given function.
+// (ai generated comment)
+func formatScalar(value any, quote func(string) string) string {
+	switch value := value.(type) {
+	case nil:
+		return `""`
+	case string:
+		return quote(value)
+	case json.Number:
+		return value.String()
+	}
+	return fmt.Sprint(value)
+}
+
+// quoteKey quotes a key unless it consists of letters, digits, underscores and dashes only.
+// (ai generated comment)
+func quoteKey(key string) string {
+	if key != "" && strings.Trim(key, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789_-") == "" {
+		return key
+	}
+	return quoteString(key)
+}
+
+// quoteString quotes a string using escapes valid in JSON, TOML and YAML.
+// (ai generated comment)
+func quoteString(s string) string {
+	b := &strings.Builder{}
+	b.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(b, `\u%04X`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,194 @@
+package prompt
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// TestDecodeYAML tests decoding of YAML defaults files
+func TestDecodeYAML(t *testing.T) {
+	doc, err := decodeYAML(`
+# defaults
+all:
+  width: 80
+input:
+  title: "Name: "   # quoted
+  placeholder: it's plain
+  default_values: [a, 'b c']
+  string_validator_func:
+    - non_empty
+    - max_length:20
+edit_map:
+  pairs: {HOST: localhost, PORT: 8080}
+slider:
+  tick_labels:
+    0: off
+    50: half
+`)
+	if err != nil {
+		t.Fatalf("decodeYAML() error = %v", err)
+	}
+	want := map[string]any{
+		"all": map[string]any{"width": int64(80)},
+		"input": map[string]any{
+			"title":                 "Name: ",
+			"placeholder":           "it's plain",
+			"default_values":        []any{"a", "b c"},
+			"string_validator_func": []any{"non_empty", "max_length:20"},
+		},
+		"edit_map": map[string]any{"pairs": map[string]any{"HOST": "localhost", "PORT": int64(8080)}},
+		"slider":   map[string]any{"tick_labels": map[string]any{"0": "off", "50": "half"}},
+	}
+	if !reflect.DeepEqual(doc, want) {
+		t.Errorf("decodeYAML() = %#v, want %#v", doc, want)
+	}
+	if _, err := decodeYAML("input:\n  title: a\n    width: 3\n"); err == nil {
+		t.Error("decodeYAML() should reject bad indentation")
+	}
+}
+
+// TestDecodeTOML tests decoding of TOML defaults files
+func TestDecodeTOML(t *testing.T) {
+	doc, err := decodeTOML(`
+[input]
+title = "Name: # not a comment"
+width = 1_000 # comment
+default_values = [
+  "a",
+  'b',
+]
+confirm_warnings = true
+
+[edit_map]
+pairs = { HOST = "localhost", "with space" = "x" }
+
+[slider.tick_labels]
+0 = "off"
+`)
+	if err != nil {
+		t.Fatalf("decodeTOML() error = %v", err)
+	}
+	want := map[string]any{
+		"input": map[string]any{
+			"title":            "Name: # not a comment",
+			"width":            int64(1000),
+			"default_values":   []any{"a", "b"},
+			"confirm_warnings": true,
+		},
+		"edit_map": map[string]any{"pairs": map[string]any{"HOST": "localhost", "with space": "x"}},
+		"slider":   map[string]any{"tick_labels": map[string]any{"0": "off"}},
+	}
+	if !reflect.DeepEqual(doc, want) {
+		t.Errorf("decodeTOML() = %#v, want %#v", doc, want)
+	}
+	if _, err := decodeTOML("[input]\ntitle\n"); err == nil {
+		t.Error("decodeTOML() should reject line without value")
+	}
+}
+
+// TestDecodeFullSyntax tests syntax beyond simple mappings: anchors, block scalars,
+// nested lists, comments after apostrophes, arrays of tables and multiline strings
+func TestDecodeFullSyntax(t *testing.T) {
+	yamlDoc, err := decodeYAML(`
+base: &base
+  width: 80
+input:
+  <<: *base
+  placeholder: it's # note
+  description: |
+    first
+    second
+  default_values:
+    - [a, b]
+    - c
+`)
+	if err != nil {
+		t.Fatalf("decodeYAML() error = %v", err)
+	}
+	tomlDoc, err := decodeTOML(`
+[input]
+description = """
+first
+second
+"""
+min_date = 2025-03-01
+
+[[input.rows]]
+name = "a"
+
+[[input.rows]]
+name = "b"
+`)
+	if err != nil {
+		t.Fatalf("decodeTOML() error = %v", err)
+	}
+	want := []map[string]any{
+		{
+			"base": map[string]any{"width": int64(80)},
+			"input": map[string]any{
+				"width":          int64(80),
+				"placeholder":    "it's",
+				"description":    "first\nsecond\n",
+				"default_values": []any{[]any{"a", "b"}, "c"},
+			},
+		},
+		{
+			"input": map[string]any{
+				"description": "first\nsecond\n",
+				"min_date":    "2025-03-01",
+				"rows":        []any{map[string]any{"name": "a"}, map[string]any{"name": "b"}},
+			},
+		},
+	}
+	for i, doc := range []map[string]any{yamlDoc, tomlDoc} {
+		if !reflect.DeepEqual(doc, want[i]) {
+			t.Errorf("decoded = %#v, want %#v", doc, want[i])
+		}
+	}
+	if _, err := decodeYAML("- a\n- b\n"); err == nil {
+		t.Error("decodeYAML() should reject a document which is not a mapping")
+	}
+}
+
+// TestConfigRoundTrip tests that encoded documents decode to the same values
+func TestConfigRoundTrip(t *testing.T) {
+	doc := map[string]any{
+		"input": map[string]any{
+			"title":          "say \"hi\"\n",
+			"width":          int64(40),
+			"default_values": []any{"a", "b"},
+		},
+		"edit_map": map[string]any{"pairs": map[string]any{"A B": "1"}},
+	}
+	for _, format := range []configFormat{formatYAML, formatTOML} {
+		data, err := encodeConfig(format, doc)
+		if err != nil {
+			t.Fatalf("encodeConfig(%v) error = %v", format, err)
+		}
+		got, err := decodeConfig(format, data)
+		if err != nil {
+			t.Fatalf("decodeConfig(%v) error = %v\n%s", format, err, data)
+		}
+		if !reflect.DeepEqual(got, doc) {
+			t.Errorf("%v round trip = %#v, want %#v\n%s", format, got, doc, data)
+		}
+	}
+	data, _ := encodeConfig(formatJSON, doc)
+	got, err := decodeConfig(formatJSON, data)
+	if err != nil || got["input"].(map[string]any)["width"] != json.Number("40") {
+		t.Errorf("JSON round trip = %v (error %v)", got, err)
+	}
+}
+
+// TestFormatOf tests format detection by file extension
+func TestFormatOf(t *testing.T) {
+	for path, want := range map[string]configFormat{"a.json": formatJSON, "a.YML": formatYAML, "a.yaml": formatYAML, "a.toml": formatTOML} {
+		if got, err := formatOf(path); err != nil || got != want {
+			t.Errorf("formatOf(%v) = %v, %v, want %v", path, got, err, want)
+		}
+	}
+	if _, err := formatOf("a.ini"); err == nil {
+		t.Error("formatOf() should reject unknown extension")
+	}
+}
@@ -1,6 +1,12 @@
 package prompt
 
-import "github.com/charmbracelet/huh"
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/charmbracelet/huh"
+)
 
 // promptType defines the types of supported prompts in the library.
 // It's used to distinguish between different prompt categories like input, selection, confirmation etc.
@@ -13,13 +19,40 @@ const (
 	ptSelectMulti promptType = "select_multi" // Multiple items selection from a list
 	ptConfirm     promptType = "confirm"      // Yes/No confirmation dialog
 	ptSearch      promptType = "search"       // Interactive search through filtered items
+	ptDate        promptType = "date"         // Date selection with calendar grid
+	ptTime        promptType = "time"         // Time of day selection
+	ptDuration    promptType = "duration"     // Duration selection
+	ptInputList   promptType = "input_list"   // Multiple string values input
+	ptEditMap     promptType = "edit_map"     // Key/value map editor
+	ptSlider      promptType = "slider"       // Single value selection on a bar
+	ptSliderRange promptType = "slider_range" // Low/high range selection on a bar
 )
 
+// promptTypes lists all prompt types in declaration order.
+// (ai generated comment)
+var promptTypes = []promptType{
+	ptInput,
+	ptSelect,
+	ptSelectMulti,
+	ptConfirm,
+	ptSearch,
+	ptDate,
+	ptTime,
+	ptDuration,
+	ptInputList,
+	ptEditMap,
+	ptSlider,
+	ptSliderRange,
+}
+
 // OptionType constrains allowed types for prompt configuration options.
 // This generic constraint ensures type safety when working with prompt options.
 // (ai generated comment)
 type OptionType interface {
-	string | int | bool | []*Item | StringValidatorFunc | ItemValidationFunc | ItemListValidationFunc | *huh.Theme
+	string | int | bool | []*Item | StringValidatorFunc | ItemValidationFunc | ItemListValidationFunc | *huh.Theme | SuggestionProviderFunc |
+		time.Time | time.Duration | *time.Location | StringListValidatorFunc | map[string]string | map[int]string | *os.File |
+		[]string | []int | []any | AsyncStringValidatorFunc | AsyncItemValidatorFunc | *History |
+		ChangeHookFunc | SubmitHookFunc | CancelHookFunc | ValidationErrorHookFunc
 }
 
 // OptionKey represents a typed option key for prompt configuration.
@@ -28,20 +61,58 @@ type OptionType interface {
 type OptionKey[T OptionType] string
 
 const (
-	KeyTitle                 OptionKey[string]                 = "title"                    // Main title displayed for the prompt
-	KeyDescription           OptionKey[string]                 = "description"              // Additional description text
-	KeyPrompt                OptionKey[string]                 = "prompt"                   // Input prompt text
-	KeyPlaceholder           OptionKey[string]                 = "placeholder"              // Placeholder text for input fields
-	KeyStringValidatorFunc   OptionKey[StringValidatorFunc]    = "string_validator_func"    // Function to validate string input
-	KeyItems                 OptionKey[[]*Item]                = "items"                    // List of selectable items
-	KeyItemValidatorFunc     OptionKey[ItemValidationFunc]     = "items_validator_func"     // Function to validate individual items
-	KeyItemListValidatorFunc OptionKey[ItemListValidationFunc] = "item_list_validator_func" // Function to validate item lists
-	KeyAffirmative           OptionKey[string]                 = "affirmative"              // "Yes" button text for confirmation
-	KeyNegative              OptionKey[string]                 = "negative"                 // "No" button text for confirmation
-	KeyWidth                 OptionKey[int]                    = "width"                    // Prompt display width
-	KeyHeight                OptionKey[int]                    = "height"                   // Prompt display height
-	KeyTheme                 OptionKey[*huh.Theme]             = "theme"                    // Visual theme for the prompt
-	KeyCaseSensitiveFilter   OptionKey[bool]                   = "case_sensitive_filter"    // Case sensitivity for search filters
+	KeyTitle                    OptionKey[string]                   = "title"                       // Main title displayed for the prompt
+	KeyDescription              OptionKey[string]                   = "description"                 // Additional description text
+	KeyPrompt                   OptionKey[string]                   = "prompt"                      // Input prompt text
+	KeyPlaceholder              OptionKey[string]                   = "placeholder"                 // Placeholder text for input fields
+	KeyStringValidatorFunc      OptionKey[StringValidatorFunc]      = "string_validator_func"       // Function to validate string input
+	KeyItems                    OptionKey[[]*Item]                  = "items"                       // List of selectable items
+	KeyItemValidatorFunc        OptionKey[ItemValidationFunc]       = "items_validator_func"        // Function to validate individual items
+	KeyItemListValidatorFunc    OptionKey[ItemListValidationFunc]   = "item_list_validator_func"    // Function to validate item lists
+	KeyAffirmative              OptionKey[string]                   = "affirmative"                 // "Yes" button text for confirmation
+	KeyNegative                 OptionKey[string]                   = "negative"                    // "No" button text for confirmation
+	KeyWidth                    OptionKey[int]                      = "width"                       // Prompt display width
+	KeyHeight                   OptionKey[int]                      = "height"                      // Prompt display height
+	KeyTheme                    OptionKey[*huh.Theme]               = "theme"                       // Visual theme for the prompt
+	KeyCaseSensitiveFilter      OptionKey[bool]                     = "case_sensitive_filter"       // Case sensitivity for search filters
+	KeySearchDescriptions       OptionKey[bool]                     = "search_descriptions"         // Match search filter against item descriptions
+	KeySuggestionProvider       OptionKey[SuggestionProviderFunc]   = "suggestion_provider"         // Source of autocomplete suggestions for input
+	KeyLayout                   OptionKey[string]                   = "layout"                      // Layout used to format and parse dates and times
+	KeyLocation                 OptionKey[*time.Location]           = "location"                    // Time zone of dates and times
+	KeyMinTime                  OptionKey[time.Time]                = "min_time"                    // Earliest allowed date or time
+	KeyMaxTime                  OptionKey[time.Time]                = "max_time"                    // Latest allowed date or time
+	KeyMinDuration              OptionKey[time.Duration]            = "min_duration"                // Shortest allowed duration
+	KeyMaxDuration              OptionKey[time.Duration]            = "max_duration"                // Longest allowed duration
+	KeyStringListValidatorFunc  OptionKey[StringListValidatorFunc]  = "string_list_validator_func"  // Function to validate string lists
+	KeyUniqueValues             OptionKey[bool]                     = "unique_values"               // Forbid duplicate values in list input
+	KeyPairs                    OptionKey[map[string]string]        = "pairs"                       // Key/value pairs edited by map editor
+	KeyKeyValidatorFunc         OptionKey[StringValidatorFunc]      = "key_validator_func"          // Function to validate map keys
+	KeyValueValidatorFunc       OptionKey[StringValidatorFunc]      = "value_validator_func"        // Function to validate map values
+	KeyMin                      OptionKey[int]                      = "min"                         // Lowest slider value
+	KeyMax                      OptionKey[int]                      = "max"                         // Highest slider value
+	KeyStep                     OptionKey[int]                      = "step"                        // Fine slider step
+	KeyCoarseStep               OptionKey[int]                      = "coarse_step"                 // Coarse slider step
+	KeyTickLabels               OptionKey[map[int]string]           = "tick_labels"                 // Named slider ticks by value
+	KeyInput                    OptionKey[*os.File]                 = "input"                       // Terminal the prompt reads keys from
+	KeyOutput                   OptionKey[*os.File]                 = "output"                      // Terminal the prompt is rendered to
+	KeyDefaultValue             OptionKey[string]                   = "default_value"               // Initial text or date, time and duration expression
+	KeyDefaultConfirm           OptionKey[bool]                     = "default_confirm"             // Initial confirmation answer
+	KeyDefaultValues            OptionKey[[]string]                 = "default_values"              // Initial values of list input
+	KeyDefaultNumbers           OptionKey[[]int]                    = "default_numbers"             // Initial slider value or range
+	KeySelected                 OptionKey[[]any]                    = "selected"                    // Preselected items identified by key or payload
+	KeyAsyncStringValidatorFunc OptionKey[AsyncStringValidatorFunc] = "async_string_validator_func" // Function to validate string input asynchronously
+	KeyAsyncItemValidatorFunc   OptionKey[AsyncItemValidatorFunc]   = "async_item_validator_func"   // Function to validate selected item asynchronously
+	KeyConfirmWarnings          OptionKey[bool]                     = "confirm_warnings"            // Require second submit when validation warns
+	KeyValidationDebounce       OptionKey[time.Duration]            = "validation_debounce"         // Delay before asynchronous validation starts
+	KeyHistoryID                OptionKey[string]                   = "history_id"                  // Prompt ID values are recorded and recalled under
+	KeyHistory                  OptionKey[*History]                 = "history"                     // Store of previously submitted values
+	KeyAccessible               OptionKey[bool]                     = "accessible"                  // Line oriented prompts for screen readers
+	KeyLanguage                 OptionKey[string]                   = "language"                    // Language of built-in strings (locale language if empty)
+	KeyOnChange                 OptionKey[ChangeHookFunc]           = "on_change"                   // Callback on value change of the open prompt
+	KeyOnSubmit                 OptionKey[SubmitHookFunc]           = "on_submit"                   // Callback before submit that may veto it
+	KeyOnCancel                 OptionKey[CancelHookFunc]           = "on_cancel"                   // Callback on cancellation
+	KeyOnValidationError        OptionKey[ValidationErrorHookFunc]  = "on_validation_error"         // Callback on rejected value
+	KeyRecordPath               OptionKey[string]                   = "record_path"                 // File sessions are recorded to (no recording if empty)
 )
 
 // PromptOption is a function type for configuring prompts through the builder pattern.
@@ -56,4 +127,26 @@ type promptBuilder struct {
 	promptType       promptType       // Type of prompt being built
 	settings         map[any]any      // Custom settings overriding defaults
 	defaultsRegistry DefaultsRegistry // Registry of default values for prompt types
+	id               int              // ID of the prompt sent with its result message
+}
+
+// newPromptBuilder creates a prompt builder for the given prompt type with default registry.
+// Applies all options, takes a snapshot of the defaults registry, so changes made while
+// the prompt runs do not affect it, and checks that required fields are present.
+// (ai generated comment)
+func newPromptBuilder(pt promptType, opts ...PromptOption) (*promptBuilder, error) {
+	pb := &promptBuilder{
+		promptType:       pt,
+		settings:         map[any]any{},
+		defaultsRegistry: defaultRegistry(),
+		id:               nextModelID(),
+	}
+	for _, modify := range opts {
+		modify(pb)
+	}
+	pb.defaultsRegistry = pb.defaultsRegistry.Clone()
+	if err := validateRequiredFields(pb); err != nil {
+		return nil, fmt.Errorf("failed field validation: %v", err)
+	}
+	return pb, nil
 }
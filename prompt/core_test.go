@@ -16,6 +16,13 @@ func TestPromptTypeValues(t *testing.T) {
 		{ptSelectMulti, "select_multi"},
 		{ptConfirm, "confirm"},
 		{ptSearch, "search"},
+		{ptDate, "date"},
+		{ptTime, "time"},
+		{ptDuration, "duration"},
+		{ptInputList, "input_list"},
+		{ptEditMap, "edit_map"},
+		{ptSlider, "slider"},
+		{ptSliderRange, "slider_range"},
 	}
 
 	for _, tt := range tests {
@@ -47,6 +54,44 @@ func TestOptionKeyValues(t *testing.T) {
 		{KeyHeight, "height"},
 		{KeyTheme, "theme"},
 		{KeyCaseSensitiveFilter, "case_sensitive_filter"},
+		{KeySearchDescriptions, "search_descriptions"},
+		{KeySuggestionProvider, "suggestion_provider"},
+		{KeyLayout, "layout"},
+		{KeyLocation, "location"},
+		{KeyMinTime, "min_time"},
+		{KeyMaxTime, "max_time"},
+		{KeyMinDuration, "min_duration"},
+		{KeyMaxDuration, "max_duration"},
+		{KeyStringListValidatorFunc, "string_list_validator_func"},
+		{KeyUniqueValues, "unique_values"},
+		{KeyPairs, "pairs"},
+		{KeyKeyValidatorFunc, "key_validator_func"},
+		{KeyValueValidatorFunc, "value_validator_func"},
+		{KeyMin, "min"},
+		{KeyMax, "max"},
+		{KeyStep, "step"},
+		{KeyCoarseStep, "coarse_step"},
+		{KeyTickLabels, "tick_labels"},
+		{KeyInput, "input"},
+		{KeyOutput, "output"},
+		{KeyDefaultValue, "default_value"},
+		{KeyDefaultConfirm, "default_confirm"},
+		{KeyDefaultValues, "default_values"},
+		{KeyDefaultNumbers, "default_numbers"},
+		{KeySelected, "selected"},
+		{KeyAsyncStringValidatorFunc, "async_string_validator_func"},
+		{KeyAsyncItemValidatorFunc, "async_item_validator_func"},
+		{KeyValidationDebounce, "validation_debounce"},
+		{KeyConfirmWarnings, "confirm_warnings"},
+		{KeyHistoryID, "history_id"},
+		{KeyHistory, "history"},
+		{KeyAccessible, "accessible"},
+		{KeyLanguage, "language"},
+		{KeyOnChange, "on_change"},
+		{KeyOnSubmit, "on_submit"},
+		{KeyOnCancel, "on_cancel"},
+		{KeyOnValidationError, "on_validation_error"},
+		{KeyRecordPath, "record_path"},
 	}
 
 	for _, tt := range tests {
@@ -101,3 +146,13 @@ func TestPromptOptionType(t *testing.T) {
 		t.Error("PromptOption should modify promptBuilder")
 	}
 }
+
+// newTestBuilder creates a prompt builder of the given type with options applied
+func newTestBuilder(t *testing.T, pt promptType, opts ...PromptOption) *promptBuilder {
+	t.Helper()
+	pb, err := newPromptBuilder(pt, opts...)
+	if err != nil {
+		t.Fatalf("newPromptBuilder() error = %v", err)
+	}
+	return pb
+}
@@ -0,0 +1,553 @@
+package prompt
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+)
+
+// timeField identifies an adjustable field of time and duration pickers.
+// (ai generated comment)
+type timeField struct {
+	label string        // Short label rendered after the field value
+	step  time.Duration // Amount added or subtracted by up/down keys
+}
+
+// timeFields lists fields of the time picker in display order.
+// (ai generated comment)
+var timeFields = []timeField{
+	{label: "h", step: time.Hour},
+	{label: "m", step: time.Minute},
+	{label: "s", step: time.Second},
+}
+
+// durationFields lists fields of the duration picker in display order.
+// (ai generated comment)
+var durationFields = []timeField{
+	{label: "d", step: 24 * time.Hour},
+	{label: "h", step: time.Hour},
+	{label: "m", step: time.Minute},
+	{label: "s", step: time.Second},
+}
+
+// timeModel represents the Bubble Tea model for date, time and duration prompts.
+// Value can be changed with arrow keys or by typing an absolute or relative expression.
+// (ai generated comment)
+type timeModel struct {
+	kind        promptType // ptDate, ptTime or ptDuration
+	title       string     // Main title displayed at the top
+	description string     // Additional description text
+	expr        string     // Typed expression
+
+	now         time.Time           // Reference point for relative expressions
+	value       time.Time           // Current date or time value
+	duration    time.Duration       // Current duration value
+	field       int                 // Index of focused field for time and duration pickers
+	layout      string              // Layout used to display and parse values
+	minTime     time.Time           // Lower bound for dates and times (zero means unbounded)
+	maxTime     time.Time           // Upper bound for dates and times (zero means unbounded)
+	minDuration time.Duration       // Lower bound for durations
+	maxDuration time.Duration       // Upper bound for durations (zero means unbounded)
+	validator   StringValidatorFunc // Validator applied to formatted value on submit
+	hooks       hooks               // Lifecycle callbacks
+
+	theme  *huh.Theme // Visual theme for consistent styling
+	msgs   localizer  // Translator of built-in strings
+	width  int        // Prompt width
+	height int        // Prompt height
+	done   bool       // Whether input is completed
+	err    error      // Error state if input fails
+	valErr error      // Last validation or parsing error
+	id     int        // ID sent with the result message
+
+	warnings warningState // Validation warning of the current value
+}
+
+// newTimeModel creates and initializes a new date, time or duration model from a configured prompt builder.
+// Initial value is current time (or zero duration) clamped to configured bounds.
+// (ai generated comment)
+func newTimeModel(pb *promptBuilder) (*timeModel, error) {
+	kind := pb.promptType
+	tm := timeModel{
+		kind:        kind,
+		title:       pb.getTitle(),
+		description: pb.getDescription(),
+		validator:   pb.getStringValidator(),
+		warnings:    warningState{confirm: pb.getConfirmWarnings()},
+		theme:       pb.getTheme(),
+		msgs:        pb.localizer(),
+		width:       pb.getWidth(),
+		height:      pb.getHeight(),
+		hooks:       pb.hooks(),
+		id:          pb.id,
+	}
+	switch kind {
+	case ptDate, ptTime:
+		tm.layout = pb.getLayout()
+		tm.now = time.Now().In(pb.getLocation())
+		tm.minTime = pb.getMinTime()
+		tm.maxTime = pb.getMaxTime()
+		if !tm.minTime.IsZero() && !tm.maxTime.IsZero() && tm.maxTime.Before(tm.minTime) {
+			return nil, fmt.Errorf("max time is before min time")
+		}
+		tm.value = tm.now.Truncate(time.Second)
+		if kind == ptDate {
+			tm.value = startOfDay(tm.now)
+		}
+		if expr := pb.getDefaultValue(); expr != "" {
+			t, err := ParseTime(expr, tm.now, tm.layout)
+			if err != nil {
+				return nil, fmt.Errorf("bad default value: %v", err)
+			}
+			tm.value = t
+			if kind == ptDate {
+				tm.value = startOfDay(t)
+			}
+		}
+		tm.value = tm.clampTime(tm.value)
+	case ptDuration:
+		tm.minDuration = pb.getMinDuration()
+		tm.maxDuration = pb.getMaxDuration()
+		if tm.maxDuration != 0 && tm.maxDuration < tm.minDuration {
+			return nil, fmt.Errorf("max duration is less than min duration")
+		}
+		d := time.Duration(0)
+		if expr := pb.getDefaultValue(); expr != "" {
+			parsed, err := ParseDuration(expr)
+			if err != nil {
+				return nil, fmt.Errorf("bad default value: %v", err)
+			}
+			d = parsed
+		}
+		tm.duration = tm.clampDuration(d)
+	default:
+		return nil, fmt.Errorf("prompt type %s is not a time prompt", kind)
+	}
+	return &tm, nil
+}
+
+// Init initializes the time model as required by the Bubble Tea Model interface.
+// (ai generated comment)
+func (tm timeModel) Init() tea.Cmd {
+	return nil
+}
+
+// resultCmd sends the duration (duration prompts), date or time or the error as result of the prompt.
+// (ai generated comment)
+func (tm timeModel) resultCmd() tea.Cmd {
+	return sendResult(tm.id, tm.resultValue(), tm.err)
+}
+
+// resultValue returns the duration of duration prompts, or the date or time.
+// (ai generated comment)
+func (tm timeModel) resultValue() any {
+	if tm.kind == ptDuration {
+		return tm.duration
+	}
+	return tm.value
+}
+
+// Update handles messages and updates the time model state.
+// Arrow keys adjust the value, printable keys edit the expression,
+// enter validates and submits.
+// (ai generated comment)
+func (tm timeModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	msgKey, ok := msg.(tea.KeyMsg)
+	if !ok || tm.done {
+		return tm, nil
+	}
+	before := tm.resultValue()
+	switch msgKey.String() {
+	case "ctrl+c":
+		tm.done = true
+		tm.err = tea.ErrInterrupted
+		tm.hooks.cancel(tm.err)
+		return tm, tm.resultCmd()
+	case "esc":
+		tm.done = true
+		tm.err = tm.msgs.wrap(ErrCanceled, "input canceled")
+		tm.hooks.cancel(tm.err)
+		return tm, tm.resultCmd()
+	case "enter":
+		if tm.expr != "" {
+			if err := tm.applyExpression(); err != nil {
+				tm.valErr = tm.hooks.invalid(tm.expr, err)
+				return tm, nil
+			}
+		}
+		if err := tm.checkBounds(); err != nil {
+			tm.valErr = tm.hooks.invalid(tm.resultValue(), err)
+			return tm, nil
+		}
+		proceed, err := tm.warnings.check(tm.validator(tm.formatValue()))
+		if err != nil {
+			tm.valErr = tm.hooks.invalid(tm.resultValue(), err)
+		}
+		if !proceed {
+			return tm, nil
+		}
+		if err := tm.hooks.submit(tm.resultValue()); err != nil {
+			tm.valErr = err
+			return tm, nil
+		}
+		tm.done = true
+		return tm, tm.resultCmd()
+	case "backspace":
+		if glyphsLen(tm.expr) > 0 {
+			letters := []rune(tm.expr)
+			tm.expr = string(letters[:len(letters)-1])
+		}
+		tm.previewExpression()
+	case "left", "right", "up", "down", "pgup", "pgdown":
+		tm.expr = ""
+		tm.valErr = nil
+		tm.adjust(msgKey.String())
+	default:
+		if glyphsLen(msgKey.String()) == 1 || msgKey.String() == " " {
+			tm.expr += msgKey.String()
+			tm.previewExpression()
+		}
+	}
+	tm.warnings.show(tm.validator(tm.formatValue()))
+	if after := tm.resultValue(); after != before {
+		tm.hooks.change(after)
+	}
+	return tm, nil
+}
+
+// adjust changes value according to an arrow or paging key.
+// (ai generated comment)
+func (tm *timeModel) adjust(keyName string) {
+	switch tm.kind {
+	case ptDate:
+		switch keyName {
+		case "left":
+			tm.value = tm.value.AddDate(0, 0, -1)
+		case "right":
+			tm.value = tm.value.AddDate(0, 0, 1)
+		case "up":
+			tm.value = tm.value.AddDate(0, 0, -7)
+		case "down":
+			tm.value = tm.value.AddDate(0, 0, 7)
+		case "pgup":
+			tm.value = tm.value.AddDate(0, -1, 0)
+		case "pgdown":
+			tm.value = tm.value.AddDate(0, 1, 0)
+		}
+		tm.value = tm.clampTime(tm.value)
+	case ptTime:
+		tm.field = moveField(tm.field, len(tm.fields()), keyName)
+		step := tm.fields()[tm.field].step
+		switch keyName {
+		case "up":
+			tm.value = tm.value.Add(step)
+		case "down":
+			tm.value = tm.value.Add(-step)
+		}
+		tm.value = tm.clampTime(tm.value)
+	case ptDuration:
+		tm.field = moveField(tm.field, len(durationFields), keyName)
+		step := durationFields[tm.field].step
+		switch keyName {
+		case "up":
+			tm.duration += step
+		case "down":
+			tm.duration -= step
+		case "pgup":
+			tm.duration += 10 * step
+		case "pgdown":
+			tm.duration -= 10 * step
+		}
+		tm.duration = tm.clampDuration(tm.duration)
+	}
+}
+
+// moveField moves focus between picker fields with left and right keys.
+// (ai generated comment)
+func moveField(field, count int, keyName string) int {
+	switch keyName {
+	case "left":
+		return max(field-1, 0)
+	case "right":
+		return min(field+1, count-1)
+	}
+	return field
+}
+
+// fields returns adjustable fields of the time picker.
+// Seconds are only shown when layout displays them.
+// (ai generated comment)
+func (tm *timeModel) fields() []timeField {
+	if strings.Contains(tm.layout, "05") {
+		return timeFields
+	}
+	return timeFields[:2]
+}
+
+// previewExpression applies the typed expression if it can be parsed.
+// Keeps previous value while expression is incomplete.
+// (ai generated comment)
+func (tm *timeModel) previewExpression() {
+	tm.valErr = nil
+	if tm.expr == "" {
+		return
+	}
+	_ = tm.applyExpression()
+}
+
+// applyExpression parses the typed expression into the current value.
+// Date prompts drop a typed time of day, so bounds and result compare whole days.
+// (ai generated comment)
+func (tm *timeModel) applyExpression() error {
+	switch tm.kind {
+	case ptDuration:
+		d, err := ParseDuration(tm.expr)
+		if err != nil {
+			return err
+		}
+		tm.duration = d
+	default:
+		t, err := ParseTime(tm.expr, tm.now, tm.layout)
+		if err != nil {
+			return err
+		}
+		if tm.kind == ptDate {
+			t = startOfDay(t)
+		}
+		tm.value = t
+	}
+	return nil
+}
+
+// clampTime limits t to configured time bounds.
+// (ai generated comment)
+func (tm *timeModel) clampTime(t time.Time) time.Time {
+	if !tm.minTime.IsZero() && t.Before(tm.minTime) {
+		return tm.minTime
+	}
+	if !tm.maxTime.IsZero() && t.After(tm.maxTime) {
+		return tm.maxTime
+	}
+	return t
+}
+
+// clampDuration limits d to configured duration bounds.
+// (ai generated comment)
+func (tm *timeModel) clampDuration(d time.Duration) time.Duration {
+	if d < tm.minDuration {
+		return tm.minDuration
+	}
+	if tm.maxDuration != 0 && d > tm.maxDuration {
+		return tm.maxDuration
+	}
+	return d
+}
+
+// checkBounds returns an error if current value is out of configured bounds.
+// (ai generated comment)
+func (tm *timeModel) checkBounds() error {
+	switch tm.kind {
+	case ptDuration:
+		if tm.duration < tm.minDuration {
+			return fmt.Errorf("duration must be at least %v", tm.minDuration)
+		}
+		if tm.maxDuration != 0 && tm.duration > tm.maxDuration {
+			return fmt.Errorf("duration must be at most %v", tm.maxDuration)
+		}
+	default:
+		if !tm.minTime.IsZero() && tm.value.Before(tm.minTime) {
+			return fmt.Errorf("value must not be before %v", tm.minTime.Format(tm.layout))
+		}
+		if !tm.maxTime.IsZero() && tm.value.After(tm.maxTime) {
+			return fmt.Errorf("value must not be after %v", tm.maxTime.Format(tm.layout))
+		}
+	}
+	return nil
+}
+
+// formatValue returns current value as text passed to the string validator.
+// (ai generated comment)
+func (tm *timeModel) formatValue() string {
+	if tm.kind == ptDuration {
+		return tm.duration.String()
+	}
+	return tm.value.Format(tm.layout)
+}
+
+// viewTitle renders the title section of the prompt.
+// (ai generated comment)
+func (tm *timeModel) viewTitle() string {
+	if tm.title == "" {
+		return ""
+	}
+	return tm.theme.Focused.TextInput.Prompt.Render("┃ ") + tm.theme.Focused.Title.Render(tm.title)
+}
+
+// viewDescription renders the description section of the prompt.
+// (ai generated comment)
+func (tm *timeModel) viewDescription() string {
+	if tm.description == "" {
+		return ""
+	}
+	return startLine(tm.theme) + tm.theme.Focused.Description.Render(tm.description)
+}
+
+// viewExpression renders the typed expression and the resulting value.
+// (ai generated comment)
+func (tm *timeModel) viewExpression() string {
+	s := startLine(tm.theme) + "value: " + tm.theme.Focused.SelectedOption.Render(tm.formatValue())
+	s += startLine(tm.theme) + "expression: " + tm.theme.Focused.TextInput.Prompt.Render(tm.expr)
+	return s + startLine(tm.theme)
+}
+
+// viewCalendar renders a month grid with the selected day highlighted.
+// Days outside of configured bounds are dimmed.
+// (ai generated comment)
+func (tm *timeModel) viewCalendar() string {
+	first := time.Date(tm.value.Year(), tm.value.Month(), 1, 0, 0, 0, 0, tm.value.Location())
+	s := startLine(tm.theme) + tm.theme.Focused.Title.Render(fmt.Sprintf("%v %v", first.Month(), first.Year()))
+	s += startLine(tm.theme) + tm.theme.Focused.Description.Render(tm.msgs.t("Mo Tu We Th Fr Sa Su"))
+	line := strings.Repeat("   ", (int(first.Weekday())+6)%7)
+	for day := first; day.Month() == first.Month(); day = day.AddDate(0, 0, 1) {
+		cell := fmt.Sprintf("%2d", day.Day())
+		switch {
+		case day.Day() == tm.value.Day():
+			cell = tm.theme.Focused.SelectedOption.Render(cell)
+		case !tm.minTime.IsZero() && day.AddDate(0, 0, 1).Before(tm.minTime),
+			!tm.maxTime.IsZero() && day.After(tm.maxTime):
+			cell = tm.theme.Focused.TextInput.Placeholder.Render(cell)
+		default:
+			cell = tm.theme.Focused.Option.Render(cell)
+		}
+		line += cell + " "
+		if day.Weekday() == time.Sunday {
+			s += startLine(tm.theme) + strings.TrimRight(line, " ")
+			line = ""
+		}
+	}
+	if line != "" {
+		s += startLine(tm.theme) + strings.TrimRight(line, " ")
+	}
+	return s
+}
+
+// viewFields renders adjustable fields with the focused one highlighted.
+// (ai generated comment)
+func (tm *timeModel) viewFields() string {
+	values := []int{}
+	fields := durationFields
+	switch tm.kind {
+	case ptTime:
+		fields = tm.fields()
+		values = append(values, tm.value.Hour(), tm.value.Minute(), tm.value.Second())
+	case ptDuration:
+		d := tm.duration
+		sign := ""
+		if d < 0 {
+			sign = "-"
+			d = -d
+		}
+		values = append(values,
+			int(d/(24*time.Hour)),
+			int(d%(24*time.Hour)/time.Hour),
+			int(d%time.Hour/time.Minute),
+			int(d%time.Minute/time.Second),
+		)
+		if sign != "" {
+			values[0] = -values[0]
+		}
+	}
+	parts := []string{}
+	for i, field := range fields {
+		part := fmt.Sprintf("%02d%v", values[i], field.label)
+		switch i == tm.field {
+		case true:
+			part = tm.theme.Focused.SelectedOption.Render(part)
+		case false:
+			part = tm.theme.Focused.Option.Render(part)
+		}
+		parts = append(parts, part)
+	}
+	return startLine(tm.theme) + strings.Join(parts, " ")
+}
+
+// viewError renders the last validation or parsing error.
+// (ai generated comment)
+func (tm *timeModel) viewError() string {
+	if tm.valErr == nil {
+		return ""
+	}
+	return startLine(tm.theme) + tm.theme.Focused.ErrorMessage.Render(tm.valErr.Error())
+}
+
+// viewHelp renders the help section with key binding instructions.
+// (ai generated comment)
+func (tm *timeModel) viewHelp() string {
+	binds := []key.Binding{
+		key.NewBinding(key.WithHelp("←/→", "select field")),
+		key.NewBinding(key.WithHelp("↑/↓", "adjust")),
+	}
+	if tm.kind == ptDate {
+		binds = []key.Binding{
+			key.NewBinding(key.WithHelp("←/→", "day")),
+			key.NewBinding(key.WithHelp("↑/↓", "week")),
+			key.NewBinding(key.WithHelp("pgup/pgdown", "month")),
+		}
+	}
+	binds = append(binds,
+		key.NewBinding(key.WithHelp("enter", "submit")),
+		key.NewBinding(key.WithHelp("esc", "cancel")),
+	)
+	return renderHelp(tm.theme, tm.msgs, binds)
+}
+
+// View renders the complete prompt interface.
+// Returns empty string if the input is completed.
+// (ai generated comment)
+func (tm timeModel) View() string {
+	if tm.done {
+		return ""
+	}
+	s := tm.viewTitle()
+	s += tm.viewDescription()
+	s += tm.viewExpression()
+	switch tm.kind {
+	case ptDate:
+		s += tm.viewCalendar()
+	default:
+		s += tm.viewFields()
+	}
+	s += tm.viewError()
+	s += tm.warnings.view(tm.theme, tm.msgs)
+	s += tm.viewHelp()
+	return s
+}
+
+// runTimeModel runs the time model and returns its final state.
+// (ai generated comment)
+func runTimeModel(kind promptType, opts ...PromptOption) (timeModel, error) {
+	pb, err := newPromptBuilder(kind, opts...)
+	if err != nil {
+		return timeModel{}, err
+	}
+	if pb.lineOriented() {
+		value, duration, err := lineTime(pb)
+		return timeModel{value: value, duration: duration}, err
+	}
+	tm, err := newTimeModel(pb)
+	if err != nil {
+		return timeModel{}, err
+	}
+	resultState, err := runModel(pb, tm)
+	if err != nil {
+		return timeModel{}, err
+	}
+	if finalModel, ok := resultState.(timeModel); ok {
+		return finalModel, finalModel.err
+	}
+	return timeModel{}, fmt.Errorf("unexpected endpoint reached")
+}
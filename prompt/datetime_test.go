@@ -0,0 +1,142 @@
+package prompt
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestTimeModelDateNavigation tests arrow key navigation in the date picker
+func TestTimeModelDateNavigation(t *testing.T) {
+	tm, err := newTimeModel(newTestBuilder(t, ptDate, WithLocation(time.UTC)))
+	if err != nil {
+		t.Fatalf("newTimeModel() error = %v", err)
+	}
+	start := tm.value
+
+	var m tea.Model = *tm
+	for _, k := range []tea.KeyType{tea.KeyRight, tea.KeyDown, tea.KeyPgDown} {
+		m, _ = m.Update(tea.KeyMsg{Type: k})
+	}
+	got := m.(timeModel).value
+	expected := start.AddDate(0, 0, 8).AddDate(0, 1, 0)
+	if !got.Equal(expected) {
+		t.Errorf("date after navigation = %v, want %v", got, expected)
+	}
+	if m.View() == "" {
+		t.Error("date picker should render calendar")
+	}
+}
+
+// TestTimeModelDateBounds tests that date picker respects min and max bounds
+func TestTimeModelDateBounds(t *testing.T) {
+	minDate := time.Date(2025, time.January, 10, 0, 0, 0, 0, time.UTC)
+	maxDate := time.Date(2025, time.January, 12, 0, 0, 0, 0, time.UTC)
+	tm, err := newTimeModel(newTestBuilder(t, ptDate, WithLocation(time.UTC), WithMinTime(minDate), WithMaxTime(maxDate)))
+	if err != nil {
+		t.Fatalf("newTimeModel() error = %v", err)
+	}
+	if !tm.value.Equal(minDate) && !tm.value.Equal(maxDate) {
+		t.Errorf("initial value %v should be clamped to bounds", tm.value)
+	}
+
+	var m tea.Model = *tm
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+	if got := m.(timeModel).value; !got.Equal(maxDate) {
+		t.Errorf("value = %v, want clamped %v", got, maxDate)
+	}
+
+	m = typeText(m, "2025-02-01")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.(timeModel).done || m.(timeModel).valErr == nil {
+		t.Error("out of bounds date should not be submitted")
+	}
+
+	for range 10 {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	m = typeText(m, "2025-01-12 9am")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if got := m.(timeModel).value; !m.(timeModel).done || !got.Equal(maxDate) {
+		t.Errorf("date with time of day = %v, done %v, want %v", got, m.(timeModel).done, maxDate)
+	}
+
+	if _, err := newTimeModel(newTestBuilder(t, ptDate, WithMinTime(maxDate), WithMaxTime(minDate))); err == nil {
+		t.Error("newTimeModel() should fail when max is before min")
+	}
+}
+
+// TestTimeModelTimeFields tests field adjustment in the time picker
+func TestTimeModelTimeFields(t *testing.T) {
+	tm, err := newTimeModel(newTestBuilder(t, ptTime, WithLayout("15:04:05")))
+	if err != nil {
+		t.Fatalf("newTimeModel() error = %v", err)
+	}
+	start := tm.value
+
+	var m tea.Model = *tm
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	got := m.(timeModel)
+	if got.field != 2 {
+		t.Errorf("focused field = %d, want 2", got.field)
+	}
+	if expected := start.Add(time.Hour - time.Second); !got.value.Equal(expected) {
+		t.Errorf("time = %v, want %v", got.value, expected)
+	}
+}
+
+// TestTimeModelDurationExpression tests typing a duration expression
+func TestTimeModelDurationExpression(t *testing.T) {
+	tm, err := newTimeModel(newTestBuilder(t, ptDuration, WithMaxDuration(2*time.Hour), WithStringValidator(Duration)))
+	if err != nil {
+		t.Fatalf("newTimeModel() error = %v", err)
+	}
+
+	var m tea.Model = *tm
+	m = typeText(m, "90m")
+	if got := m.(timeModel).duration; got != 90*time.Minute {
+		t.Errorf("duration preview = %v, want 90m", got)
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.(timeModel).done {
+		t.Errorf("duration should be submitted, error: %v", m.(timeModel).valErr)
+	}
+
+	m = *tm
+	m = typeText(m, "3h")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.(timeModel).done {
+		t.Error("duration above max should not be submitted")
+	}
+
+	m = *tm
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if got := m.(timeModel).duration; got != 0 {
+		t.Errorf("duration = %v, want clamped to 0", got)
+	}
+}
+
+// TestTimeModelDefaultValue tests that default expressions set initial values
+func TestTimeModelDefaultValue(t *testing.T) {
+	tm, err := newTimeModel(newTestBuilder(t, ptDate, WithLocation(time.UTC), WithDefaultValue("2025-03-01")))
+	if err != nil {
+		t.Fatalf("newTimeModel() error = %v", err)
+	}
+	if expected := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC); !tm.value.Equal(expected) {
+		t.Errorf("date = %v, want %v", tm.value, expected)
+	}
+	tm, err = newTimeModel(newTestBuilder(t, ptDuration, WithDefaultValue("1h30m")))
+	if err != nil {
+		t.Fatalf("newTimeModel() error = %v", err)
+	}
+	if tm.duration != 90*time.Minute {
+		t.Errorf("duration = %v, want 1h30m", tm.duration)
+	}
+	if _, err := newTimeModel(newTestBuilder(t, ptDuration, WithDefaultValue("soon"))); err == nil {
+		t.Error("expected error for bad default value")
+	}
+}
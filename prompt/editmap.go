@@ -0,0 +1,501 @@
+package prompt
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+)
+
+// ValueChange describes a value replaced in an edited map.
+// (ai generated comment)
+type ValueChange struct {
+	Old string // Value before editing
+	New string // Value after editing
+}
+
+// MapDiff describes changes made to a map by the EditMap prompt.
+// (ai generated comment)
+type MapDiff struct {
+	Added   map[string]string      // Pairs missing in the original map
+	Removed map[string]string      // Pairs deleted from the original map (with old values)
+	Changed map[string]ValueChange // Keys whose values were changed
+}
+
+// IsEmpty reports whether the diff contains no changes.
+// (ai generated comment)
+func (d MapDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// DiffMaps compares two maps and returns the changes needed to turn before into after.
+// (ai generated comment)
+func DiffMaps(before, after map[string]string) MapDiff {
+	diff := MapDiff{
+		Added:   map[string]string{},
+		Removed: map[string]string{},
+		Changed: map[string]ValueChange{},
+	}
+	for k, oldValue := range before {
+		newValue, exists := after[k]
+		switch {
+		case !exists:
+			diff.Removed[k] = oldValue
+		case newValue != oldValue:
+			diff.Changed[k] = ValueChange{Old: oldValue, New: newValue}
+		}
+	}
+	for k, newValue := range after {
+		if _, exists := before[k]; !exists {
+			diff.Added[k] = newValue
+		}
+	}
+	return diff
+}
+
+// mapRow is a single key/value pair displayed by the map editor.
+// (ai generated comment)
+type mapRow struct {
+	key   string
+	value string
+}
+
+// mapModel represents the Bubble Tea model for the key/value map editor.
+// Rows keep stable order: original keys sorted, new keys appended at the end.
+// (ai generated comment)
+type mapModel struct {
+	title       string // Main title displayed at the top
+	description string // Additional description text
+
+	original       map[string]string   // Map passed to the prompt
+	rows           []mapRow            // Current pairs in display order
+	cursor         cursor              // Cursor management for selection
+	editing        bool                // Whether a row is being edited
+	newRow         bool                // Whether edited row was just added
+	column         int                 // Edited column: 0 for key, 1 for value
+	keyInput       textinput.Model     // Editor for the key column
+	valueInput     textinput.Model     // Editor for the value column
+	keyValidator   StringValidatorFunc // Validator applied to keys
+	valueValidator StringValidatorFunc // Validator applied to values
+	hooks          hooks               // Lifecycle callbacks
+
+	theme  *huh.Theme // Visual theme for consistent styling
+	msgs   localizer  // Translator of built-in strings
+	width  int        // Prompt width
+	height int        // Prompt height
+	done   bool       // Whether editing is completed
+	err    error      // Error state if editing fails
+	valErr error      // Last validation error shown under the table
+	id     int        // ID sent with the result message
+
+	warnings warningState // Validation warning of the last committed row
+}
+
+// newMapModel creates and initializes a new map editor model from a configured prompt builder.
+// Returns the map model or an error if initialization fails.
+// (ai generated comment)
+func newMapModel(pb *promptBuilder) (*mapModel, error) {
+	theme := pb.getTheme()
+	original := maps.Clone(pb.getPairs())
+	if original == nil {
+		original = map[string]string{}
+	}
+	mm := mapModel{
+		title:          pb.getTitle(),
+		description:    pb.getDescription(),
+		original:       original,
+		rows:           []mapRow{},
+		cursor:         defaultCursor,
+		keyInput:       newMapCellInput(theme),
+		valueInput:     newMapCellInput(theme),
+		keyValidator:   pb.getKeyValidator(),
+		warnings:       warningState{confirm: pb.getConfirmWarnings()},
+		valueValidator: pb.getValueValidator(),
+		theme:          theme,
+		msgs:           pb.localizer(),
+		width:          pb.getWidth(),
+		height:         max(pb.getHeight(), 10),
+		hooks:          pb.hooks(),
+		id:             pb.id,
+	}
+	for _, k := range slices.Sorted(maps.Keys(original)) {
+		mm.rows = append(mm.rows, mapRow{key: k, value: original[k]})
+	}
+	return &mm, nil
+}
+
+// newMapCellInput creates a text input styled for a map editor cell.
+// (ai generated comment)
+func newMapCellInput(theme *huh.Theme) textinput.Model {
+	ti := textinput.New()
+	ti.Prompt = ""
+	ti.PromptStyle = theme.Focused.TextInput.Prompt
+	ti.TextStyle = theme.Focused.TextInput.Text
+	ti.PlaceholderStyle = theme.Focused.TextInput.Placeholder
+	ti.Cursor.Style = theme.Focused.TextInput.Cursor
+	ti.Cursor.TextStyle = theme.Focused.TextInput.CursorText
+	return ti
+}
+
+// Init initializes the map model as required by the Bubble Tea Model interface.
+// (ai generated comment)
+func (mm mapModel) Init() tea.Cmd {
+	return nil
+}
+
+// replayMode returns the model with steady cursors for session replay.
+// (ai generated comment)
+func (mm mapModel) replayMode() tea.Model {
+	steadyCursor(&mm.keyInput)
+	steadyCursor(&mm.valueInput)
+	return mm
+}
+
+// Update handles messages and updates the map model state.
+// Delegates to browsing or editing handlers depending on current mode.
+// (ai generated comment)
+func (mm mapModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if mm.done {
+		return mm, nil
+	}
+	msgKey, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return mm.updateInputs(msg)
+	}
+	if msgKey.String() == "ctrl+c" {
+		mm.done = true
+		mm.err = tea.ErrInterrupted
+		mm.hooks.cancel(mm.err)
+		return mm, mm.resultCmd()
+	}
+	if mm.editing {
+		return mm.updateEditing(msgKey)
+	}
+	mm.valErr = nil
+	mm.warnings.show(nil)
+	switch msgKey.String() {
+	case "esc":
+		mm.done = true
+		mm.err = mm.msgs.wrap(ErrCanceled, "editing canceled")
+		mm.hooks.cancel(mm.err)
+		return mm, mm.resultCmd()
+	case "up":
+		mm.cursor.index = max(mm.cursor.index-1, 0)
+	case "down":
+		mm.cursor.index = min(mm.cursor.index+1, max(len(mm.rows)-1, 0))
+	case "enter", "e":
+		if len(mm.rows) > 0 {
+			return mm.startEditing(false)
+		}
+	case "a", "n":
+		mm.rows = append(mm.rows, mapRow{})
+		mm.cursor.index = len(mm.rows) - 1
+		return mm.startEditing(true)
+	case "d", "delete":
+		if len(mm.rows) > 0 {
+			mm.rows = slices.Delete(mm.rows, mm.cursor.index, mm.cursor.index+1)
+			mm.cursor.index = min(mm.cursor.index, max(len(mm.rows)-1, 0))
+			mm.hooks.change(mm.result())
+		}
+	case "s", "ctrl+s":
+		if err := mm.hooks.submit(mm.resultValue()); err != nil {
+			mm.valErr = err
+			return mm, nil
+		}
+		mm.done = true
+		return mm, mm.resultCmd()
+	}
+	mm.adjustOffset()
+	return mm, nil
+}
+
+// updateEditing handles keys while a row is being edited.
+// Tab switches columns, enter commits the row, esc discards changes.
+// (ai generated comment)
+func (mm mapModel) updateEditing(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		if mm.newRow {
+			mm.rows = slices.Delete(mm.rows, mm.cursor.index, mm.cursor.index+1)
+			mm.cursor.index = min(mm.cursor.index, max(len(mm.rows)-1, 0))
+		}
+		mm.stopEditing()
+		return mm, nil
+	case "tab", "shift+tab":
+		mm.column = 1 - mm.column
+		return mm, mm.focusColumn()
+	case "enter":
+		committed, err := mm.commitRow()
+		if err != nil {
+			row := map[string]string{strings.TrimSpace(mm.keyInput.Value()): mm.valueInput.Value()}
+			mm.valErr = mm.hooks.invalid(row, err)
+		}
+		if !committed {
+			return mm, nil
+		}
+		mm.stopEditing()
+		mm.hooks.change(mm.result())
+		return mm, nil
+	}
+	mm.valErr = nil
+	mm.warnings.show(nil)
+	return mm.updateInputs(msg)
+}
+
+// updateInputs passes a message to the focused cell editor.
+// (ai generated comment)
+func (mm mapModel) updateInputs(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	switch mm.column {
+	case 0:
+		mm.keyInput, cmd = mm.keyInput.Update(msg)
+	default:
+		mm.valueInput, cmd = mm.valueInput.Update(msg)
+	}
+	return mm, cmd
+}
+
+// startEditing switches to edit mode for the row under cursor.
+// (ai generated comment)
+func (mm mapModel) startEditing(newRow bool) (tea.Model, tea.Cmd) {
+	row := mm.rows[mm.cursor.index]
+	mm.editing = true
+	mm.newRow = newRow
+	mm.column = 0
+	mm.keyInput.SetValue(row.key)
+	mm.valueInput.SetValue(row.value)
+	mm.keyInput.CursorEnd()
+	mm.valueInput.CursorEnd()
+	mm.adjustOffset()
+	return mm, mm.focusColumn()
+}
+
+// stopEditing leaves edit mode.
+// (ai generated comment)
+func (mm *mapModel) stopEditing() {
+	mm.editing = false
+	mm.newRow = false
+	mm.keyInput.Blur()
+	mm.valueInput.Blur()
+	mm.adjustOffset()
+}
+
+// focusColumn focuses the editor of the current column.
+// (ai generated comment)
+func (mm *mapModel) focusColumn() tea.Cmd {
+	if mm.column == 0 {
+		mm.valueInput.Blur()
+		return mm.keyInput.Focus()
+	}
+	mm.keyInput.Blur()
+	return mm.valueInput.Focus()
+}
+
+// commitRow validates edited key and value and stores them in the row under cursor.
+// Returns whether the row was stored or a blocking validation error.
+// Rows with warnings are stored, after confirmation if it is required.
+// (ai generated comment)
+func (mm *mapModel) commitRow() (bool, error) {
+	k := strings.TrimSpace(mm.keyInput.Value())
+	v := mm.valueInput.Value()
+	if k == "" {
+		return false, fmt.Errorf("key is empty")
+	}
+	for i, row := range mm.rows {
+		if i != mm.cursor.index && row.key == k {
+			return false, fmt.Errorf("key %v already exists", k)
+		}
+	}
+	keyErr, valueErr := mm.keyValidator(k), mm.valueValidator(v)
+	if isBlocking(keyErr) {
+		return false, fmt.Errorf("key: %v", keyErr)
+	}
+	if isBlocking(valueErr) {
+		return false, fmt.Errorf("value: %v", valueErr)
+	}
+	var warning error
+	switch {
+	case keyErr != nil:
+		warning = Warn("key: %v", keyErr)
+	case valueErr != nil:
+		warning = Warn("value: %v", valueErr)
+	}
+	if proceed, _ := mm.warnings.check(warning); !proceed {
+		return false, nil
+	}
+	mm.rows[mm.cursor.index] = mapRow{key: k, value: v}
+	return true, nil
+}
+
+// result builds the resulting map from current rows.
+// (ai generated comment)
+func (mm *mapModel) result() map[string]string {
+	m := make(map[string]string, len(mm.rows))
+	for _, row := range mm.rows {
+		m[row.key] = row.value
+	}
+	return m
+}
+
+// resultCmd sends the resulting pairs with their diff or the error as result of the prompt.
+// (ai generated comment)
+func (mm mapModel) resultCmd() tea.Cmd {
+	return sendResult(mm.id, mm.resultValue(), mm.err)
+}
+
+// resultValue returns resulting pairs with their diff against the original pairs.
+// (ai generated comment)
+func (mm mapModel) resultValue() MapResult {
+	result := mm.result()
+	return MapResult{Pairs: result, Diff: DiffMaps(mm.original, result)}
+}
+
+// maxListHeight calculates the number of rows that fit the prompt height.
+// (ai generated comment)
+func (mm *mapModel) maxListHeight() int {
+	n := 6
+	if mm.description != "" {
+		n += len(strings.Split(mm.description, "\n"))
+	}
+	return max(mm.height-n, 1)
+}
+
+// adjustOffset keeps cursor inside the visible part of the table.
+// (ai generated comment)
+func (mm *mapModel) adjustOffset() {
+	visible := mm.maxListHeight()
+	if mm.cursor.index < mm.cursor.offset {
+		mm.cursor.offset = mm.cursor.index
+	}
+	if mm.cursor.index >= mm.cursor.offset+visible {
+		mm.cursor.offset = mm.cursor.index - visible + 1
+	}
+}
+
+// keyColumnWidth returns width of the key column based on the longest key.
+// (ai generated comment)
+func (mm *mapModel) keyColumnWidth() int {
+	w := 3
+	for _, row := range mm.rows {
+		w = max(w, glyphsLen(row.key))
+	}
+	if mm.width > 0 {
+		w = min(w, max(mm.width/2-4, 3))
+	}
+	return w
+}
+
+// viewTitle renders the title section of the map editor.
+// (ai generated comment)
+func (mm *mapModel) viewTitle() string {
+	if mm.title == "" {
+		return ""
+	}
+	return mm.theme.Focused.TextInput.Prompt.Render("┃ ") + mm.theme.Focused.Title.Render(mm.title)
+}
+
+// viewDescription renders the description section of the map editor.
+// (ai generated comment)
+func (mm *mapModel) viewDescription() string {
+	if mm.description == "" {
+		return ""
+	}
+	return startLine(mm.theme) + mm.theme.Focused.Description.Render(mm.description)
+}
+
+// viewTable renders visible pairs in two columns.
+// The row under cursor is rendered with editors while editing.
+// (ai generated comment)
+func (mm *mapModel) viewTable() string {
+	keyWidth := mm.keyColumnWidth()
+	s := startLine(mm.theme) + mm.theme.Focused.Description.Render(
+		mm.cursor.unselected+padRight(mm.msgs.t("key"), keyWidth)+" │ "+mm.msgs.t("value"))
+	if len(mm.rows) == 0 {
+		return s + startLine(mm.theme) + mm.theme.Focused.TextInput.Placeholder.Render(mm.cursor.unselected+mm.msgs.t("no pairs"))
+	}
+	end := min(mm.cursor.offset+mm.maxListHeight(), len(mm.rows))
+	for i := mm.cursor.offset; i < end; i++ {
+		row := mm.rows[i]
+		cursStr := mm.cursor.unselected
+		if i == mm.cursor.index {
+			cursStr = mm.cursor.selected
+		}
+		line := mm.theme.Focused.SelectedOption.Render(cursStr)
+		switch {
+		case mm.editing && i == mm.cursor.index:
+			line += mm.keyInput.View() + " │ " + mm.valueInput.View()
+		case i == mm.cursor.index:
+			line += mm.theme.Focused.SelectedOption.Render(padRight(truncate(row.key, keyWidth), keyWidth) + " │ " + row.value)
+		default:
+			line += mm.theme.Focused.Option.Render(padRight(truncate(row.key, keyWidth), keyWidth) + " │ " + row.value)
+		}
+		s += startLine(mm.theme) + line
+	}
+	return s
+}
+
+// padRight pads s with spaces to the given number of glyphs.
+// (ai generated comment)
+func padRight(s string, width int) string {
+	return s + strings.Repeat(" ", max(width-glyphsLen(s), 0))
+}
+
+// truncate shortens s to the given number of glyphs, marking the cut with an ellipsis.
+// (ai generated comment)
+func truncate(s string, width int) string {
+	letters := []rune(s)
+	if len(letters) <= width {
+		return s
+	}
+	return string(letters[:max(width-1, 0)]) + "…"
+}
+
+// viewError renders the last validation error.
+// (ai generated comment)
+func (mm *mapModel) viewError() string {
+	if mm.valErr == nil {
+		return ""
+	}
+	return startLine(mm.theme) + mm.theme.Focused.ErrorMessage.Render(mm.valErr.Error())
+}
+
+// viewHelp renders the help section with key binding instructions for current mode.
+// (ai generated comment)
+func (mm *mapModel) viewHelp() string {
+	if mm.editing {
+		return renderHelp(mm.theme, mm.msgs, []key.Binding{
+			key.NewBinding(key.WithHelp("tab", "switch column")),
+			key.NewBinding(key.WithHelp("enter", "apply")),
+			key.NewBinding(key.WithHelp("esc", "discard")),
+		})
+	}
+	return renderHelp(mm.theme, mm.msgs, []key.Binding{
+		key.NewBinding(key.WithHelp("↑/↓", "move cursor")),
+		key.NewBinding(key.WithHelp("enter", "edit")),
+		key.NewBinding(key.WithHelp("a", "add")),
+		key.NewBinding(key.WithHelp("d", "delete")),
+		key.NewBinding(key.WithHelp("s", "save")),
+		key.NewBinding(key.WithHelp("esc", "cancel")),
+	})
+}
+
+// View renders the complete map editor interface.
+// Returns empty string if editing is completed.
+// (ai generated comment)
+func (mm mapModel) View() string {
+	if mm.done {
+		return ""
+	}
+	s := mm.viewTitle()
+	s += mm.viewDescription()
+	s += mm.viewTable()
+	s += mm.viewError()
+	s += mm.warnings.view(mm.theme, mm.msgs)
+	s += mm.viewHelp()
+	return s
+}
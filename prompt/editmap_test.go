@@ -0,0 +1,143 @@
+package prompt
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// newTestMapModel creates a map model from the given options for tests
+func newTestMapModel(t *testing.T, opts ...PromptOption) tea.Model {
+	mm, err := newMapModel(newTestBuilder(t, ptEditMap, opts...))
+	if err != nil {
+		t.Fatalf("newMapModel() error = %v", err)
+	}
+	return *mm
+}
+
+// pressKeys sends named keys to the model one by one
+func pressKeys(m tea.Model, keys ...tea.KeyType) tea.Model {
+	for _, k := range keys {
+		m, _ = m.Update(tea.KeyMsg{Type: k})
+	}
+	return m
+}
+
+// TestDiffMaps tests computing of map differences
+func TestDiffMaps(t *testing.T) {
+	before := map[string]string{"a": "1", "b": "2", "c": "3"}
+	after := map[string]string{"a": "1", "b": "20", "d": "4"}
+
+	diff := DiffMaps(before, after)
+	expected := MapDiff{
+		Added:   map[string]string{"d": "4"},
+		Removed: map[string]string{"c": "3"},
+		Changed: map[string]ValueChange{"b": {Old: "2", New: "20"}},
+	}
+	if !reflect.DeepEqual(diff, expected) {
+		t.Errorf("DiffMaps() = %+v, want %+v", diff, expected)
+	}
+	if diff.IsEmpty() {
+		t.Error("IsEmpty() should be false for changed maps")
+	}
+	if !DiffMaps(before, before).IsEmpty() {
+		t.Error("IsEmpty() should be true for equal maps")
+	}
+}
+
+// TestMapModelStableOrder tests that rows are sorted and new rows are appended
+func TestMapModelStableOrder(t *testing.T) {
+	original := map[string]string{"zeta": "1", "alpha": "2"}
+	m := newTestMapModel(t, FromMap(original))
+
+	m = typeText(m, "a")
+	m = typeText(m, "beta")
+	m = pressKeys(m, tea.KeyTab)
+	m = typeText(m, "3")
+	m = pressKeys(m, tea.KeyEnter)
+
+	mm := m.(mapModel)
+	keys := []string{}
+	for _, row := range mm.rows {
+		keys = append(keys, row.key)
+	}
+	if !reflect.DeepEqual(keys, []string{"alpha", "zeta", "beta"}) {
+		t.Errorf("row order = %v, want [alpha zeta beta]", keys)
+	}
+	if len(original) != 2 {
+		t.Error("original map should not be modified")
+	}
+}
+
+// TestMapModelEditAndDelete tests editing and deleting rows
+func TestMapModelEditAndDelete(t *testing.T) {
+	m := newTestMapModel(t, FromMap(map[string]string{"a": "1", "b": "2"}))
+
+	// edit value of "a"
+	m = pressKeys(m, tea.KeyEnter, tea.KeyTab, tea.KeyBackspace)
+	m = typeText(m, "10")
+	m = pressKeys(m, tea.KeyEnter)
+	// delete "b"
+	m = pressKeys(m, tea.KeyDown)
+	m = typeText(m, "d")
+	m = typeText(m, "s")
+
+	mm := m.(mapModel)
+	if !mm.done {
+		t.Fatal("s should save the map")
+	}
+	result := mm.result()
+	if !reflect.DeepEqual(result, map[string]string{"a": "10"}) {
+		t.Errorf("result = %v, want map[a:10]", result)
+	}
+	diff := DiffMaps(mm.original, result)
+	if len(diff.Changed) != 1 || len(diff.Removed) != 1 {
+		t.Errorf("diff = %+v, want one change and one removal", diff)
+	}
+}
+
+// TestMapModelValidation tests key and value validators and duplicate keys
+func TestMapModelValidation(t *testing.T) {
+	m := newTestMapModel(t,
+		FromMap(map[string]string{"PORT": "80"}),
+		WithKeyValidator(func(s string) error {
+			if strings.ToUpper(s) != s {
+				return fmt.Errorf("must be upper case")
+			}
+			return nil
+		}),
+		WithValueValidator(Integer),
+	)
+
+	m = typeText(m, "a")
+	m = typeText(m, "port")
+	m = pressKeys(m, tea.KeyEnter)
+	if m.(mapModel).valErr == nil {
+		t.Error("lower case key should be rejected")
+	}
+
+	m = pressKeys(m, tea.KeyBackspace, tea.KeyBackspace, tea.KeyBackspace, tea.KeyBackspace)
+	m = typeText(m, "PORT")
+	m = pressKeys(m, tea.KeyEnter)
+	if m.(mapModel).valErr == nil {
+		t.Error("duplicate key should be rejected")
+	}
+
+	m = pressKeys(m, tea.KeyBackspace)
+	m = typeText(m, "S")
+	m = pressKeys(m, tea.KeyTab)
+	m = typeText(m, "x")
+	m = pressKeys(m, tea.KeyEnter)
+	if m.(mapModel).valErr == nil {
+		t.Error("non integer value should be rejected")
+	}
+
+	m = pressKeys(m, tea.KeyEsc)
+	mm := m.(mapModel)
+	if mm.editing || len(mm.rows) != 1 {
+		t.Errorf("esc should discard new row, rows = %v", mm.rows)
+	}
+}
@@ -0,0 +1,264 @@
+package prompt
+
+import (
+	"errors"
+	"sync/atomic"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ResultMsg is sent by an embedded prompt model when the user submits or leaves the prompt.
+// Value holds the result of the prompt type (see the model constructors) and is nil on error.
+// Err wraps ErrCanceled or huh.ErrUserAborted when the user canceled the prompt
+// and is tea.ErrInterrupted after ctrl+c.
+// (ai generated comment)
+type ResultMsg struct {
+	ID    int   // ID of the model that sent the message
+	Value any   // Submitted value
+	Err   error // Reason the prompt ended without value
+}
+
+// MapResult is the value of EditMap models: resulting pairs and the diff against the original ones.
+// (ai generated comment)
+type MapResult struct {
+	Pairs map[string]string // Resulting pairs
+	Diff  MapDiff           // Changes against the original pairs
+}
+
+// lastModelID is the ID of the most recently built prompt.
+// (ai generated comment)
+var lastModelID atomic.Int64
+
+// nextModelID returns an ID unique for the process.
+// (ai generated comment)
+func nextModelID() int {
+	return int(lastModelID.Add(1))
+}
+
+// sendResult returns the command sending the result of the prompt with the ID.
+// (ai generated comment)
+func sendResult(id int, value any, err error) tea.Cmd {
+	if err != nil {
+		value = nil
+	}
+	return func() tea.Msg {
+		return ResultMsg{ID: id, Value: value, Err: err}
+	}
+}
+
+// Model is a prompt that can be embedded into a Bubble Tea program as a sub-component.
+// Instead of quitting the program it sends a ResultMsg with its ID on submit or cancel.
+// Forward messages to Update and render View as part of the parent view.
+// (ai generated comment)
+type Model struct {
+	id     int        // ID sent with the result message
+	prompt promptType // Prompt type, written to session recordings
+	model  tea.Model  // Wrapped prompt model
+}
+
+// ID returns the ID the model sends with its ResultMsg.
+// (ai generated comment)
+func (m Model) ID() int {
+	return m.id
+}
+
+// Init initializes the wrapped prompt model.
+// (ai generated comment)
+func (m Model) Init() tea.Cmd {
+	return m.model.Init()
+}
+
+// Update passes the message to the wrapped prompt model.
+// (ai generated comment)
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	m.model, cmd = m.model.Update(msg)
+	return m, cmd
+}
+
+// View renders the wrapped prompt model. It is empty once the prompt is completed.
+// (ai generated comment)
+func (m Model) View() string {
+	return m.model.View()
+}
+
+// replayMode prepares the wrapped prompt model for session replay.
+// (ai generated comment)
+func (m Model) replayMode() tea.Model {
+	m.model = replayMode(m.model)
+	return m
+}
+
+// standaloneModel runs a prompt model as the whole program: it quits on the first result,
+// or interrupts the program if the user pressed ctrl+c.
+// (ai generated comment)
+type standaloneModel struct {
+	model tea.Model // Prompt model
+}
+
+// Init initializes the prompt model.
+// (ai generated comment)
+func (sm standaloneModel) Init() tea.Cmd {
+	return sm.model.Init()
+}
+
+// Update passes messages to the prompt model and ends the program on its result.
+// (ai generated comment)
+func (sm standaloneModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if result, ok := msg.(ResultMsg); ok {
+		if errors.Is(result.Err, tea.ErrInterrupted) {
+			return sm, tea.Interrupt
+		}
+		return sm, tea.Quit
+	}
+	var cmd tea.Cmd
+	sm.model, cmd = sm.model.Update(msg)
+	return sm, cmd
+}
+
+// View renders the prompt model.
+// (ai generated comment)
+func (sm standaloneModel) View() string {
+	return sm.model.View()
+}
+
+// embed builds a prompt with the constructor and wraps it into an embeddable Model.
+// (ai generated comment)
+func embed(pt promptType, build func(*promptBuilder) (tea.Model, error), opts ...PromptOption) (Model, error) {
+	pb, err := newPromptBuilder(pt, opts...)
+	if err != nil {
+		return Model{}, err
+	}
+	model, err := build(pb)
+	if err != nil {
+		return Model{}, err
+	}
+	return Model{id: pb.id, prompt: pt, model: model}, nil
+}
+
+// NewInputModel creates an embeddable text input prompt. Result value is a string.
+// (ai generated comment)
+func NewInputModel(opts ...PromptOption) (Model, error) {
+	return embed(ptInput, func(pb *promptBuilder) (tea.Model, error) {
+		return *newInputModel(pb), nil
+	}, opts...)
+}
+
+// NewSelectModel creates an embeddable single selection prompt. Result value is an *Item.
+// (ai generated comment)
+func NewSelectModel(opts ...PromptOption) (Model, error) {
+	return embed(ptSelect, buildSelectModel, opts...)
+}
+
+// NewSelectMultipleModel creates an embeddable multiple selection prompt. Result value is a []*Item.
+// (ai generated comment)
+func NewSelectMultipleModel(opts ...PromptOption) (Model, error) {
+	return embed(ptSelectMulti, buildSelectModel, opts...)
+}
+
+// buildSelectModel builds a selection model as tea.Model.
+// (ai generated comment)
+func buildSelectModel(pb *promptBuilder) (tea.Model, error) {
+	sm, err := newSelectModel(pb)
+	if err != nil {
+		return nil, err
+	}
+	return *sm, nil
+}
+
+// NewConfirmModel creates an embeddable yes/no prompt. Result value is a bool.
+// (ai generated comment)
+func NewConfirmModel(opts ...PromptOption) (Model, error) {
+	return embed(ptConfirm, func(pb *promptBuilder) (tea.Model, error) {
+		val := new(bool)
+		*val = pb.getDefaultConfirm()
+		return newConfirmForm(pb, val), nil
+	}, opts...)
+}
+
+// NewSearchModel creates an embeddable search prompt. Result value is an *Item.
+// (ai generated comment)
+func NewSearchModel(opts ...PromptOption) (Model, error) {
+	return embed(ptSearch, func(pb *promptBuilder) (tea.Model, error) {
+		pb.floatRecentItems()
+		sm, err := newSearch(pb)
+		if err != nil {
+			return nil, err
+		}
+		return *sm, nil
+	}, opts...)
+}
+
+// NewInputListModel creates an embeddable list input prompt. Result value is a []string.
+// (ai generated comment)
+func NewInputListModel(opts ...PromptOption) (Model, error) {
+	return embed(ptInputList, func(pb *promptBuilder) (tea.Model, error) {
+		lm, err := newListModel(pb)
+		if err != nil {
+			return nil, err
+		}
+		return *lm, nil
+	}, opts...)
+}
+
+// NewEditMapModel creates an embeddable key/value editor. Result value is a MapResult.
+// (ai generated comment)
+func NewEditMapModel(opts ...PromptOption) (Model, error) {
+	return embed(ptEditMap, func(pb *promptBuilder) (tea.Model, error) {
+		mm, err := newMapModel(pb)
+		if err != nil {
+			return nil, err
+		}
+		return *mm, nil
+	}, opts...)
+}
+
+// NewSliderModel creates an embeddable slider prompt. Result value is an int.
+// (ai generated comment)
+func NewSliderModel(opts ...PromptOption) (Model, error) {
+	return embed(ptSlider, buildSliderModel, opts...)
+}
+
+// NewSliderRangeModel creates an embeddable range slider prompt. Result value is a [2]int with low and high values.
+// (ai generated comment)
+func NewSliderRangeModel(opts ...PromptOption) (Model, error) {
+	return embed(ptSliderRange, buildSliderModel, opts...)
+}
+
+// buildSliderModel builds a slider model as tea.Model.
+// (ai generated comment)
+func buildSliderModel(pb *promptBuilder) (tea.Model, error) {
+	sm, err := newSliderModel(pb)
+	if err != nil {
+		return nil, err
+	}
+	return *sm, nil
+}
+
+// NewDateModel creates an embeddable date prompt. Result value is a time.Time.
+// (ai generated comment)
+func NewDateModel(opts ...PromptOption) (Model, error) {
+	return embed(ptDate, buildTimeModel, opts...)
+}
+
+// NewTimeModel creates an embeddable time of day prompt. Result value is a time.Time.
+// (ai generated comment)
+func NewTimeModel(opts ...PromptOption) (Model, error) {
+	return embed(ptTime, buildTimeModel, opts...)
+}
+
+// NewDurationModel creates an embeddable duration prompt. Result value is a time.Duration.
+// (ai generated comment)
+func NewDurationModel(opts ...PromptOption) (Model, error) {
+	return embed(ptDuration, buildTimeModel, opts...)
+}
+
+// buildTimeModel builds a date, time or duration model as tea.Model.
+// (ai generated comment)
+func buildTimeModel(pb *promptBuilder) (tea.Model, error) {
+	tm, err := newTimeModel(pb)
+	if err != nil {
+		return nil, err
+	}
+	return *tm, nil
+}
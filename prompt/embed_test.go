@@ -0,0 +1,200 @@
+package prompt
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+)
+
+// awaitResult executes commands, feeding messages back to the model, until a result message is sent
+func awaitResult(t *testing.T, m tea.Model, cmd tea.Cmd) ResultMsg {
+	t.Helper()
+	_, result := pump(t, m, cmd)
+	if result == nil {
+		t.Fatal("model sent no result")
+	}
+	return *result
+}
+
+// pump executes commands, feeding messages back to the model, until they run out or a result message is sent
+func pump(t *testing.T, m tea.Model, cmd tea.Cmd) (tea.Model, *ResultMsg) {
+	t.Helper()
+	queue := []tea.Cmd{cmd}
+	for steps := 0; len(queue) > 0 && steps < 50; steps++ {
+		next := queue[0]
+		queue = queue[1:]
+		if next == nil {
+			continue
+		}
+		switch msg := execute(next).(type) {
+		case nil:
+		case ResultMsg:
+			return m, &msg
+		case tea.BatchMsg:
+			queue = append(queue, msg...)
+		case tea.QuitMsg:
+			t.Fatal("embedded model quit the program")
+		default:
+			var c tea.Cmd
+			m, c = m.Update(msg)
+			queue = append(queue, c)
+		}
+	}
+	return m, nil
+}
+
+// sendKeys sends key presses to the model and returns the command of the last one
+func sendKeys(m tea.Model, keys ...tea.KeyMsg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	for _, k := range keys {
+		m, cmd = m.Update(k)
+	}
+	return m, cmd
+}
+
+// TestEmbeddedModels tests that embedded models send typed results with their ID instead of quitting
+func TestEmbeddedModels(t *testing.T) {
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	right := tea.KeyMsg{Type: tea.KeyRight}
+	space := tea.KeyMsg{Type: tea.KeySpace}
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	items := func() []*Item { return []*Item{NewItem("a"), NewItem("b")} }
+	tests := []struct {
+		name  string
+		build func() (Model, error)
+		keys  []tea.KeyMsg
+		want  any
+	}{
+		{"input", func() (Model, error) { return NewInputModel(WithDefaultValue("hello")) }, []tea.KeyMsg{enter}, "hello"},
+		{"select", func() (Model, error) { return NewSelectModel(FromItems(items())) }, []tea.KeyMsg{down, enter}, "b"},
+		{"select multiple", func() (Model, error) { return NewSelectMultipleModel(FromItems(items())) }, []tea.KeyMsg{space, down, space, enter}, []string{"a", "b"}},
+		{"search", func() (Model, error) { return NewSearchModel(FromItems(items())) }, []tea.KeyMsg{enter}, "a"},
+		{"input list", func() (Model, error) { return NewInputListModel(WithDefaultValues("x", "y")) }, []tea.KeyMsg{enter}, []string{"x", "y"}},
+		{"slider", func() (Model, error) { return NewSliderModel(WithMin(0), WithMax(10), WithStep(1)) }, []tea.KeyMsg{right, right, enter}, 2},
+		{"slider range", func() (Model, error) { return NewSliderRangeModel(WithMin(0), WithMax(10), WithStep(1)) }, []tea.KeyMsg{right, enter}, [2]int{1, 10}},
+		{"duration", func() (Model, error) { return NewDurationModel(WithDefaultValue("90m")) }, []tea.KeyMsg{enter}, 90 * time.Minute},
+		{"edit map", func() (Model, error) { return NewEditMapModel(FromMap(map[string]string{"k": "v"})) }, []tea.KeyMsg{{Type: tea.KeyCtrlS}}, map[string]string{"k": "v"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model, err := tt.build()
+			if err != nil {
+				t.Fatal(err)
+			}
+			m, cmd := sendKeys(model, tt.keys...)
+			result := awaitResult(t, m, cmd)
+			if result.ID != model.ID() || result.Err != nil {
+				t.Fatalf("result = %+v, want value of model %v", result, model.ID())
+			}
+			got := result.Value
+			switch v := got.(type) {
+			case *Item:
+				got = v.Key()
+			case []*Item:
+				keys := []string{}
+				for _, item := range v {
+					keys = append(keys, item.Key())
+				}
+				got = keys
+			case MapResult:
+				got = v.Pairs
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("result value = %#v, want %#v", got, tt.want)
+			}
+			if m.View() != "" && tt.name != "search" {
+				t.Errorf("View() of completed model = %q, want empty", m.View())
+			}
+		})
+	}
+}
+
+// TestEmbeddedCancel tests that canceled and interrupted models report errors in the result
+func TestEmbeddedCancel(t *testing.T) {
+	a, _ := NewInputModel()
+	b, _ := NewInputModel()
+	if a.ID() == b.ID() {
+		t.Fatalf("models share ID %v", a.ID())
+	}
+	m, cmd := a.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if result := awaitResult(t, m, cmd); !IsCanceled(result.Err) || result.Value != nil {
+		t.Errorf("esc result = %+v, want cancellation", result)
+	}
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil {
+		t.Error("completed model should ignore further keys")
+	}
+	m, cmd = b.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+	if result := awaitResult(t, m, cmd); !errors.Is(result.Err, tea.ErrInterrupted) {
+		t.Errorf("ctrl+c result = %+v, want interruption", result)
+	}
+}
+
+// TestEmbeddedConfirm tests that the confirm form sends its answer as result
+func TestEmbeddedConfirm(t *testing.T) {
+	model, err := NewConfirmModel(WithTitle("Proceed?"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, _ := model.Update(model.Init()())
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if result := awaitResult(t, m, cmd); result.ID != model.ID() || result.Value != true {
+		t.Errorf("result = %+v, want confirmation", result)
+	}
+	model, _ = NewConfirmModel()
+	m, cmd = model.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+	if result := awaitResult(t, m, cmd); !errors.Is(result.Err, huh.ErrUserAborted) {
+		t.Errorf("ctrl+c result = %+v, want abort", result)
+	}
+}
+
+// TestStandaloneModel tests that standalone programs quit on result and interrupt on ctrl+c
+func TestStandaloneModel(t *testing.T) {
+	sm := standaloneModel{model: newTestInputModel()}
+	if _, cmd := sm.Update(ResultMsg{Value: "x"}); execute(cmd) != (tea.QuitMsg{}) {
+		t.Error("result should quit the program")
+	}
+	if _, cmd := sm.Update(ResultMsg{Err: tea.ErrInterrupted}); execute(cmd) != (tea.InterruptMsg{}) {
+		t.Error("interrupted result should interrupt the program")
+	}
+}
+
+// pipeTerminal returns options running prompt models on a pipe with the keys as input
+func pipeTerminal(t *testing.T, keys string) []PromptOption {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.WriteString(keys)
+	w.Close()
+	out, err := os.Create(filepath.Join(t.TempDir(), "out"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		r.Close()
+		out.Close()
+	})
+	return []PromptOption{WithInput(r), WithOutput(out)}
+}
+
+// TestRunModel tests that blocking prompts run the embeddable models until their result
+func TestRunModel(t *testing.T) {
+	pb := newTestBuilder(t, ptInput, pipeTerminal(t, "hi\r")...)
+	final, err := runModel(pb, newInputModel(pb))
+	if im, ok := final.(inputModel); err != nil || !ok || im.value != "hi" {
+		t.Errorf("runModel() = %+v, %v, want input hi", final, err)
+	}
+	pb = newTestBuilder(t, ptConfirm, pipeTerminal(t, "y")...)
+	val := false
+	final, err = runModel(pb, newConfirmForm(pb, &val))
+	if form, ok := final.(*huh.Form); err != nil || !ok || form.State != huh.StateCompleted || !val {
+		t.Errorf("runModel() = %v, %v, want confirmation", val, err)
+	}
+}
@@ -0,0 +1,52 @@
+package prompt
+
+import (
+	"errors"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+)
+
+// ErrCanceled is returned (wrapped) when the user cancels a prompt with esc.
+// Use IsCanceled to also detect interrupts and aborted huh forms. Cancel errors of Input,
+// SelectSingle and SelectMultiple also match huh.ErrUserAborted, as they did when these ran huh forms.
+// (ai generated comment)
+var ErrCanceled = errors.New("canceled")
+
+// IsCanceled reports whether err means the user left the prompt without submitting.
+// Matches ErrCanceled as well as interrupts from Bubble Tea and aborted huh forms.
+// (ai generated comment)
+func IsCanceled(err error) bool {
+	return errors.Is(err, ErrCanceled) ||
+		errors.Is(err, tea.ErrInterrupted) ||
+		errors.Is(err, huh.ErrUserAborted)
+}
+
+// abortedError is a cancel error that also matches huh.ErrUserAborted.
+// The message is the one of the cancel error.
+// (ai generated comment)
+type abortedError struct {
+	err error // Cancel error of the prompt
+}
+
+// Error returns the message of the cancel error.
+// (ai generated comment)
+func (e *abortedError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the cancel error and huh.ErrUserAborted.
+// (ai generated comment)
+func (e *abortedError) Unwrap() []error {
+	return []error{e.err, huh.ErrUserAborted}
+}
+
+// compatAborted wraps cancel errors so errors.Is(err, huh.ErrUserAborted) keeps holding
+// for callers of prompts that used to run huh forms. Other errors are returned unchanged.
+// (ai generated comment)
+func compatAborted(err error) error {
+	if IsCanceled(err) && !errors.Is(err, huh.ErrUserAborted) {
+		return &abortedError{err: err}
+	}
+	return err
+}
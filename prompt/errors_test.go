@@ -0,0 +1,63 @@
+package prompt
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+)
+
+// TestIsCanceled tests detection of canceled prompts
+func TestIsCanceled(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"wrapped ErrCanceled", fmt.Errorf("search %w", ErrCanceled), true},
+		{"interrupt", tea.ErrInterrupted, true},
+		{"aborted form", huh.ErrUserAborted, true},
+		{"other error", errors.New("boom"), false},
+		{"nil", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsCanceled(tt.err); got != tt.expected {
+				t.Errorf("IsCanceled(%v) = %v, want %v", tt.err, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestCanceledMessage tests that wrapped cancel errors keep readable messages
+func TestCanceledMessage(t *testing.T) {
+	if got := fmt.Errorf("search %w", ErrCanceled).Error(); got != "search canceled" {
+		t.Errorf("message = %q, want %q", got, "search canceled")
+	}
+}
+
+// TestCanceledCompatibility tests that cancel errors of former huh prompts still match huh.ErrUserAborted
+func TestCanceledCompatibility(t *testing.T) {
+	items := FromItems(NewItemList("a", "b"))
+	prompts := map[string]func(opts ...PromptOption) error{
+		"Input":          func(opts ...PromptOption) error { _, err := Input(opts...); return err },
+		"SelectSingle":   func(opts ...PromptOption) error { _, err := SelectSingle(append(opts, items)...); return err },
+		"SelectMultiple": func(opts ...PromptOption) error { _, err := SelectMultiple(append(opts, items)...); return err },
+	}
+	for name, run := range prompts {
+		opts, _ := lineTerminal(t, "")
+		err := run(opts...)
+		if !errors.Is(err, huh.ErrUserAborted) || !errors.Is(err, ErrCanceled) {
+			t.Errorf("%v() error = %v, want ErrCanceled matching huh.ErrUserAborted", name, err)
+		}
+	}
+	if err := compatAborted(errors.New("boom")); errors.Is(err, huh.ErrUserAborted) {
+		t.Errorf("compatAborted() = %v, want other errors unchanged", err)
+	}
+	if err := compatAborted(tea.ErrInterrupted); !errors.Is(err, huh.ErrUserAborted) || err.Error() != tea.ErrInterrupted.Error() {
+		t.Errorf("compatAborted(interrupt) = %v, want interrupt matching huh.ErrUserAborted", err)
+	}
+}
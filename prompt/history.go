@@ -0,0 +1,259 @@
+package prompt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// defaultHistoryLimit is the number of entries kept per prompt ID when no limit is set.
+// (ai generated comment)
+const defaultHistoryLimit = 100
+
+// RedactFunc is called before a value is stored in history.
+// Returns the value to store (e.g. with secrets masked) and whether to store it at all.
+// (ai generated comment)
+type RedactFunc func(id, value string) (string, bool)
+
+// History is a file-backed store of previously submitted values keyed by prompt ID.
+// Each ID is kept in its own file, most recent entry first, without duplicates.
+// History is safe for concurrent use within one process.
+// (ai generated comment)
+type History struct {
+	dir    string     // Directory holding one file per prompt ID
+	limit  int        // Maximum number of entries kept per prompt ID
+	redact RedactFunc // Hook filtering values before they are stored
+	mu     sync.Mutex // Guards file access
+}
+
+// NewHistory creates a history store in the directory keeping at most limit entries per prompt ID.
+// Non-positive limit means the default of 100 entries.
+// (ai generated comment)
+func NewHistory(dir string, limit int) *History {
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+	return &History{dir: dir, limit: limit}
+}
+
+// WithRedaction sets the hook applied to values before they are stored.
+// Returns the history for method chaining.
+// (ai generated comment)
+func (h *History) WithRedaction(redact RedactFunc) *History {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.redact = redact
+	return h
+}
+
+// Dir returns the directory the history is stored in.
+// (ai generated comment)
+func (h *History) Dir() string {
+	return h.dir
+}
+
+var (
+	defaultHistory     *History
+	defaultHistoryOnce sync.Once
+)
+
+// DefaultHistory returns the history store used by prompts without explicit store.
+// It is located in consolio/history under $XDG_STATE_HOME (~/.local/state by default).
+// (ai generated comment)
+func DefaultHistory() *History {
+	defaultHistoryOnce.Do(func() {
+		defaultHistory = NewHistory(filepath.Join(stateDir(), "consolio", "history"), defaultHistoryLimit)
+	})
+	return defaultHistory
+}
+
+// ClearHistory removes all entries of the prompt ID from the default history store.
+// (ai generated comment)
+func ClearHistory(id string) error {
+	return DefaultHistory().Clear(id)
+}
+
+// stateDir returns the XDG state directory of the user.
+// (ai generated comment)
+func stateDir() string {
+	if dir := os.Getenv("XDG_STATE_HOME"); filepath.IsAbs(dir) {
+		return dir
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(os.TempDir(), ".local", "state")
+	}
+	return filepath.Join(home, ".local", "state")
+}
+
+// path returns the file storing entries of the prompt ID.
+// (ai generated comment)
+func (h *History) path(id string) string {
+	return filepath.Join(h.dir, url.PathEscape(id)+".json")
+}
+
+// Entries returns stored values of the prompt ID, most recent first.
+// Returns empty list if nothing was stored yet.
+// (ai generated comment)
+func (h *History) Entries(id string) ([]string, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.load(id)
+}
+
+// load reads entries of the prompt ID from its file.
+// (ai generated comment)
+func (h *History) load(id string) ([]string, error) {
+	data, err := os.ReadFile(h.path(id))
+	if errors.Is(err, fs.ErrNotExist) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history: %v", err)
+	}
+	entries := []string{}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to decode history %v: %v", id, err)
+	}
+	return entries, nil
+}
+
+// Add stores the value as the most recent entry of the prompt ID.
+// Empty values and values rejected by the redaction hook are not stored,
+// a repeated value is moved to the top and the oldest entries over the limit are dropped.
+// (ai generated comment)
+func (h *History) Add(id, value string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.redact != nil {
+		var keep bool
+		if value, keep = h.redact(id, value); !keep {
+			return nil
+		}
+	}
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	entries, err := h.load(id)
+	if err != nil {
+		entries = []string{}
+	}
+	entries = slices.DeleteFunc(entries, func(e string) bool { return e == value })
+	entries = append([]string{value}, entries...)
+	if len(entries) > h.limit {
+		entries = entries[:h.limit]
+	}
+	return h.save(id, entries)
+}
+
+// save writes entries of the prompt ID replacing its file atomically.
+// (ai generated comment)
+func (h *History) save(id string, entries []string) error {
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("failed to encode history: %v", err)
+	}
+	if err := os.MkdirAll(h.dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create history directory: %v", err)
+	}
+	tmp, err := os.CreateTemp(h.dir, ".history-*")
+	if err != nil {
+		return fmt.Errorf("failed to write history: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write history: %v", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write history: %v", err)
+	}
+	if err := os.Rename(tmp.Name(), h.path(id)); err != nil {
+		return fmt.Errorf("failed to write history: %v", err)
+	}
+	return nil
+}
+
+// Clear removes all entries of the prompt ID.
+// (ai generated comment)
+func (h *History) Clear(id string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if err := os.Remove(h.path(id)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to clear history: %v", err)
+	}
+	return nil
+}
+
+// ClearAll removes entries of all prompt IDs.
+// (ai generated comment)
+func (h *History) ClearAll() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if err := os.RemoveAll(h.dir); err != nil {
+		return fmt.Errorf("failed to clear history: %v", err)
+	}
+	return nil
+}
+
+// historyEntries returns history of the prompt or nil if history is not enabled.
+// Unreadable history is treated as empty.
+// (ai generated comment)
+func (pb *promptBuilder) historyEntries() []string {
+	id := pb.getHistoryID()
+	if id == "" {
+		return nil
+	}
+	entries, err := pb.getHistory().Entries(id)
+	if err != nil {
+		return nil
+	}
+	return entries
+}
+
+// recordHistory stores the submitted value if history is enabled.
+// History is best effort: failure to store it does not fail the prompt.
+// (ai generated comment)
+func (pb *promptBuilder) recordHistory(value string) {
+	if id := pb.getHistoryID(); id != "" {
+		_ = pb.getHistory().Add(id, value)
+	}
+}
+
+// floatRecentItems moves items recently chosen in the prompt to the top of the item list.
+// (ai generated comment)
+func (pb *promptBuilder) floatRecentItems() {
+	if recent := pb.historyEntries(); len(recent) > 0 {
+		setTo(pb, KeyItems, recentFirst(pb.getItems(), recent))
+	}
+}
+
+// recentFirst returns items ordered by position of their keys in recent list,
+// keeping original order of the rest.
+// (ai generated comment)
+func recentFirst(items []*Item, recent []string) []*Item {
+	rank := make(map[string]int, len(recent))
+	for i, key := range recent {
+		rank[key] = i
+	}
+	ordered := slices.Clone(items)
+	slices.SortStableFunc(ordered, func(a, b *Item) int {
+		ra, ok := rank[a.Key()]
+		if !ok {
+			ra = len(recent)
+		}
+		rb, ok := rank[b.Key()]
+		if !ok {
+			rb = len(recent)
+		}
+		return ra - rb
+	})
+	return ordered
+}
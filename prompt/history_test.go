@@ -0,0 +1,136 @@
+package prompt
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestHistoryAddAndLimit tests ordering, deduplication and size limit of entries
+func TestHistoryAddAndLimit(t *testing.T) {
+	h := NewHistory(t.TempDir(), 3)
+	for _, v := range []string{"a", "b", "c", "b", "d", " "} {
+		if err := h.Add("hosts", v); err != nil {
+			t.Fatalf("Add(%q) error = %v", v, err)
+		}
+	}
+	got, err := h.Entries("hosts")
+	if err != nil {
+		t.Fatalf("Entries() error = %v", err)
+	}
+	if want := []string{"d", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Entries() = %v, want %v", got, want)
+	}
+	if got, _ := h.Entries("other"); len(got) != 0 {
+		t.Errorf("Entries() of unknown ID = %v, want empty", got)
+	}
+}
+
+// TestHistoryRedaction tests that the redaction hook can mask and skip values
+func TestHistoryRedaction(t *testing.T) {
+	h := NewHistory(t.TempDir(), 0).WithRedaction(func(id, value string) (string, bool) {
+		if strings.HasPrefix(value, "secret") {
+			return "", false
+		}
+		return strings.ReplaceAll(value, "hunter2", "***"), true
+	})
+	h.Add("cmd", "login -p hunter2")
+	h.Add("cmd", "secret-token")
+	got, _ := h.Entries("cmd")
+	if want := []string{"login -p ***"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Entries() = %v, want %v", got, want)
+	}
+}
+
+// TestHistoryClear tests clearing of one and all prompt IDs
+func TestHistoryClear(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "history")
+	h := NewHistory(dir, 0)
+	h.Add("a/b", "1")
+	h.Add("c", "2")
+	if err := h.Clear("a/b"); err != nil {
+		t.Fatalf("Clear() error = %v", err)
+	}
+	if got, _ := h.Entries("a/b"); len(got) != 0 {
+		t.Errorf("Entries() after Clear() = %v, want empty", got)
+	}
+	if got, _ := h.Entries("c"); len(got) != 1 {
+		t.Errorf("Clear() should keep other IDs, got %v", got)
+	}
+	if err := h.ClearAll(); err != nil {
+		t.Fatalf("ClearAll() error = %v", err)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Error("ClearAll() should remove history directory")
+	}
+	if err := h.Clear("missing"); err != nil {
+		t.Errorf("Clear() of missing ID error = %v", err)
+	}
+}
+
+// TestDefaultHistoryLocation tests that default history is stored in XDG state directory
+func TestDefaultHistoryLocation(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", "/tmp/state")
+	if got := stateDir(); got != "/tmp/state" {
+		t.Errorf("stateDir() = %v, want /tmp/state", got)
+	}
+	t.Setenv("XDG_STATE_HOME", "relative")
+	if got := stateDir(); !strings.HasSuffix(got, filepath.Join(".local", "state")) {
+		t.Errorf("stateDir() = %v, want fallback to ~/.local/state", got)
+	}
+}
+
+// TestRecentFirst tests that recently used items are floated in history order
+func TestRecentFirst(t *testing.T) {
+	items := NewItemList("a", "b", "c", "d")
+	got := []string{}
+	for _, item := range recentFirst(items, []string{"c", "x", "a"}) {
+		got = append(got, item.Key())
+	}
+	if want := []string{"c", "a", "b", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("recentFirst() = %v, want %v", got, want)
+	}
+}
+
+// TestInputModelHistory tests recall of previous values with up and down arrows
+func TestInputModelHistory(t *testing.T) {
+	h := NewHistory(t.TempDir(), 0)
+	h.Add("name", "old")
+	h.Add("name", "new")
+	var m tea.Model = newTestInputModel(WithHistory("name"), WithHistoryStore(h))
+	m = typeText(m, "dra")
+
+	m = pressKeys(m, tea.KeyUp)
+	if got := m.(inputModel).textInput.Value(); got != "new" {
+		t.Errorf("first recall = %q, want new", got)
+	}
+	m = pressKeys(m, tea.KeyUp, tea.KeyUp)
+	if got := m.(inputModel).textInput.Value(); got != "old" {
+		t.Errorf("recall past oldest = %q, want old", got)
+	}
+	m = pressKeys(m, tea.KeyDown, tea.KeyDown)
+	if got := m.(inputModel).textInput.Value(); got != "dra" {
+		t.Errorf("recall past newest = %q, want draft dra", got)
+	}
+	m = pressKeys(m, tea.KeyUp)
+	m = typeText(m, "er")
+	m = pressKeys(m, tea.KeyEnter)
+	if im := m.(inputModel); !im.done || im.value != "newer" {
+		t.Errorf("value = %q (done %v), want edited recall newer", im.value, im.done)
+	}
+}
+
+// TestFloatRecentItems tests that the builder reorders items by history
+func TestFloatRecentItems(t *testing.T) {
+	h := NewHistory(t.TempDir(), 0)
+	pb := newTestBuilder(t, ptSearch, FromItems(NewItemList("a", "b", "c")), WithHistory("pick"), WithHistoryStore(h))
+	pb.recordHistory("c")
+	pb.floatRecentItems()
+	if got := pb.getItems()[0].Key(); got != "c" {
+		t.Errorf("first item = %v, want c", got)
+	}
+}
@@ -0,0 +1,105 @@
+package prompt
+
+// ChangeHookFunc is called while the prompt is open whenever its current value changes.
+// Value is the text of Input, the filter text or highlighted *Item of search, the highlighted *Item
+// of single and chosen []*Item of multiple selection, the []string values of InputList, the pairs of EditMap,
+// the bool answer of Confirm, an int or [2]int of sliders and a time.Time or time.Duration of time prompts.
+// (ai generated comment)
+type ChangeHookFunc func(value any)
+
+// SubmitHookFunc is called with the result value (see ResultMsg) before the prompt completes.
+// A non-nil error vetoes the submit: the prompt stays open and shows the error.
+// (ai generated comment)
+type SubmitHookFunc func(value any) error
+
+// CancelHookFunc is called when the user cancels or interrupts the prompt.
+// (ai generated comment)
+type CancelHookFunc func(err error)
+
+// ValidationErrorHookFunc is called when a validator rejects the value the user tries to submit.
+// Line-oriented prompts report the rejected answer text. Warnings are not reported.
+// (ai generated comment)
+type ValidationErrorHookFunc func(value any, err error)
+
+// changeAccessor stores the value of a huh field and reports its changes to OnChange callback.
+// (ai generated comment)
+type changeAccessor[T comparable] struct {
+	value *T    // Variable holding the field value
+	hooks hooks // Lifecycle callbacks
+}
+
+// Get returns the field value.
+// (ai generated comment)
+func (a *changeAccessor[T]) Get() T {
+	return *a.value
+}
+
+// Set stores the field value and reports it if it changed.
+// (ai generated comment)
+func (a *changeAccessor[T]) Set(value T) {
+	if value == *a.value {
+		return
+	}
+	*a.value = value
+	a.hooks.change(value)
+}
+
+// hooks holds lifecycle callbacks of a prompt. Callbacks that are not set are skipped.
+// (ai generated comment)
+type hooks struct {
+	onChange          ChangeHookFunc          // Called on value change
+	onSubmit          SubmitHookFunc          // Called before completion, may veto
+	onCancel          CancelHookFunc          // Called on cancellation
+	onValidationError ValidationErrorHookFunc // Called on rejected value
+}
+
+// hooks returns lifecycle callbacks configured in the prompt builder.
+// (ai generated comment)
+func (pb *promptBuilder) hooks() hooks {
+	return hooks{
+		onChange:          pb.getOnChange(),
+		onSubmit:          pb.getOnSubmit(),
+		onCancel:          pb.getOnCancel(),
+		onValidationError: pb.getOnValidationError(),
+	}
+}
+
+// set reports whether any callback is configured.
+// (ai generated comment)
+func (h hooks) set() bool {
+	return h.onChange != nil || h.onSubmit != nil || h.onCancel != nil || h.onValidationError != nil
+}
+
+// change reports a new value of the open prompt.
+// (ai generated comment)
+func (h hooks) change(value any) {
+	if h.onChange != nil {
+		h.onChange(value)
+	}
+}
+
+// submit asks whether the value may be submitted. Returns the veto error.
+// (ai generated comment)
+func (h hooks) submit(value any) error {
+	if h.onSubmit == nil {
+		return nil
+	}
+	return h.onSubmit(value)
+}
+
+// cancel reports cancellation of the prompt.
+// (ai generated comment)
+func (h hooks) cancel(err error) {
+	if h.onCancel != nil {
+		h.onCancel(err)
+	}
+}
+
+// invalid reports a blocking validation error of the value and returns the error.
+// (ai generated comment)
+func (h hooks) invalid(value any, err error) error {
+	if h.onValidationError != nil && isBlocking(err) {
+		h.onValidationError(value, err)
+	}
+	return err
+}
@@ -0,0 +1,180 @@
+package prompt
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// hookRecorder collects calls of lifecycle callbacks
+type hookRecorder struct {
+	changes  []any
+	submits  []any
+	canceled []error
+	invalid  []any
+	veto     func(value any) error
+}
+
+// options returns prompt options registering all callbacks of the recorder
+func (hr *hookRecorder) options() []PromptOption {
+	return []PromptOption{
+		WithOnChange(func(value any) { hr.changes = append(hr.changes, value) }),
+		WithOnSubmit(func(value any) error {
+			hr.submits = append(hr.submits, value)
+			if hr.veto != nil {
+				return hr.veto(value)
+			}
+			return nil
+		}),
+		WithOnCancel(func(err error) { hr.canceled = append(hr.canceled, err) }),
+		WithOnValidationError(func(value any, _ error) { hr.invalid = append(hr.invalid, value) }),
+	}
+}
+
+// vetoKey returns a submit veto rejecting the item with the key
+func vetoKey(key string) func(any) error {
+	return func(value any) error {
+		if item, ok := value.(*Item); ok && item.Key() == key {
+			return fmt.Errorf("%v is reserved", key)
+		}
+		return nil
+	}
+}
+
+// TestHooksSearch tests change reports and submit veto of the search model
+func TestHooksSearch(t *testing.T) {
+	hr := &hookRecorder{veto: vetoKey("banana")}
+	items := []*Item{NewItem("apple"), NewItem("banana"), NewItem("blueberry")}
+	sm, err := newSearch(newTestBuilder(t, ptSearch, append(hr.options(), FromItems(items))...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := typeText(*sm, "b")
+	if len(hr.changes) != 2 || hr.changes[0] != "b" || hr.changes[1] != items[1] {
+		t.Fatalf("changes = %v, want filter and highlighted item", hr.changes)
+	}
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil || m.(searchModel).completed() {
+		t.Fatal("vetoed submit should keep the search open")
+	}
+	if !strings.Contains(m.View(), "banana is reserved") {
+		t.Errorf("View() should show the veto:\n%v", m.View())
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if result := awaitResult(t, m, cmd); result.Value != items[2] {
+		t.Errorf("result = %+v, want blueberry", result)
+	}
+	if len(hr.submits) != 2 || len(hr.canceled) != 0 {
+		t.Errorf("submits = %v, canceled = %v", hr.submits, hr.canceled)
+	}
+}
+
+// TestHooksInput tests validation error and cancel reports of the input model
+func TestHooksInput(t *testing.T) {
+	hr := &hookRecorder{}
+	var m tea.Model = newTestInputModel(append(hr.options(), WithStringValidator(Integer))...)
+	m = typeText(m, "x")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !reflect.DeepEqual(hr.invalid, []any{"x"}) || len(hr.submits) != 0 {
+		t.Errorf("invalid = %v, submits = %v, want rejected x", hr.invalid, hr.submits)
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if len(hr.canceled) != 1 || !IsCanceled(hr.canceled[0]) {
+		t.Errorf("canceled = %v, want cancellation", hr.canceled)
+	}
+	if !reflect.DeepEqual(hr.changes, []any{"x"}) {
+		t.Errorf("changes = %v, want typed text", hr.changes)
+	}
+}
+
+// TestHooksSelectMultiple tests change reports and veto of multiple selection
+func TestHooksSelectMultiple(t *testing.T) {
+	hr := &hookRecorder{veto: func(value any) error {
+		if len(value.([]*Item)) < 2 {
+			return errors.New("choose two")
+		}
+		return nil
+	}}
+	var m tea.Model = newTestSelectModel(t, ptSelectMulti, hr.options()...)
+	m = pressKeys(m, tea.KeySpace, tea.KeyEnter)
+	if m.(selectModel).done || !strings.Contains(m.View(), "choose two") {
+		t.Fatalf("vetoed selection should stay open with message:\n%v", m.View())
+	}
+	m = pressKeys(m, tea.KeyDown, tea.KeyDown, tea.KeySpace, tea.KeyEnter)
+	if !m.(selectModel).done || len(hr.changes) != 2 {
+		t.Errorf("done = %v, changes = %v, want completed after two toggles", m.(selectModel).done, hr.changes)
+	}
+}
+
+// TestHooksSlider tests change reports and veto of the slider
+func TestHooksSlider(t *testing.T) {
+	hr := &hookRecorder{veto: func(value any) error {
+		if value.(int) == 1 {
+			return errors.New("odd")
+		}
+		return nil
+	}}
+	m := newTestSliderModel(t, ptSlider, append(hr.options(), WithMin(0), WithMax(10))...)
+	m = pressKeys(m, tea.KeyLeft, tea.KeyRight, tea.KeyEnter)
+	if m.(sliderModel).done || !strings.Contains(m.View(), "odd") {
+		t.Fatalf("vetoed value should keep the slider open:\n%v", m.View())
+	}
+	m = pressKeys(m, tea.KeyRight, tea.KeyEnter)
+	if !m.(sliderModel).done || !reflect.DeepEqual(hr.changes, []any{1, 2}) {
+		t.Errorf("done = %v, changes = %v, want completed with two changes", m.(sliderModel).done, hr.changes)
+	}
+}
+
+// TestHooksConfirm tests change reports and veto of the confirm form
+func TestHooksConfirm(t *testing.T) {
+	hr := &hookRecorder{veto: func(value any) error {
+		if value == false {
+			return errors.New("please agree")
+		}
+		return nil
+	}}
+	model, err := NewConfirmModel(hr.options()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, _ := model.Update(model.Init()())
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	m, result := pump(t, m, cmd)
+	if result != nil || !strings.Contains(m.View(), "please agree") {
+		t.Fatalf("vetoed answer should keep the form open, result %v:\n%v", result, m.View())
+	}
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if result := awaitResult(t, m, cmd); result.Value != true {
+		t.Errorf("result = %+v, want confirmation", result)
+	}
+	if !reflect.DeepEqual(hr.changes, []any{true}) {
+		t.Errorf("changes = %v, want answer change", hr.changes)
+	}
+}
+
+// TestHooksLines tests veto, validation error and cancel reports of line oriented prompts
+func TestHooksLines(t *testing.T) {
+	hr := &hookRecorder{veto: func(value any) error {
+		if value == "admin" {
+			return errors.New("admin is reserved")
+		}
+		return nil
+	}}
+	opts, output := lineTerminal(t, "\nadmin\nroot\n")
+	val, err := Input(append(append(opts, hr.options()...), WithStringValidator(NonEmpty))...)
+	if err != nil || val != "root" {
+		t.Fatalf("Input() = %q, %v, want root", val, err)
+	}
+	if !strings.Contains(output(), "Error: admin is reserved") || !reflect.DeepEqual(hr.invalid, []any{""}) {
+		t.Errorf("invalid = %v, output:\n%v", hr.invalid, output())
+	}
+	opts, _ = lineTerminal(t, "")
+	if _, err := Confirm(append(opts, hr.options()...)...); !IsCanceled(err) || len(hr.canceled) != 1 {
+		t.Errorf("Confirm() error = %v, canceled = %v, want cancellation", err, hr.canceled)
+	}
+}
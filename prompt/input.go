@@ -0,0 +1,354 @@
+package prompt
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+)
+
+// defaultDropdownHeight is the number of suggestions shown when prompt height is not set.
+// (ai generated comment)
+const defaultDropdownHeight = 5
+
+// inputModel represents the Bubble Tea model for the text input prompt with autocomplete.
+// It wraps a text input, queries the suggestion provider on every change
+// and renders matching suggestions as ghost text and as a dropdown list.
+// (ai generated comment)
+type inputModel struct {
+	title       string // Main title displayed at the top
+	description string // Additional description text
+
+	textInput textinput.Model          // Underlying text input component
+	provider  SuggestionProviderFunc   // Source of autocomplete suggestions
+	validator StringValidatorFunc      // Validator applied on submit
+	async     *asyncValidation[string] // Asynchronous validator checked while typing
+	hooks     hooks                    // Lifecycle callbacks
+	warnings  warningState             // Validation warning of the current text
+	cursor    cursor                   // Cursor symbols used in the dropdown
+
+	history      []string // Previously submitted values, most recent first
+	historyIndex int      // Position of recalled value in history, -1 when editing draft
+	draft        string   // Text typed before history recall started
+
+	theme  *huh.Theme // Visual theme for consistent styling
+	msgs   localizer  // Translator of built-in strings
+	width  int        // Prompt width
+	height int        // Prompt height
+	value  string     // Submitted value
+	done   bool       // Whether input is completed
+	err    error      // Error state if input fails
+	valErr error      // Last validation error shown under the field
+	id     int        // ID sent with the result message
+}
+
+// newInputModel creates and initializes a new input model from a configured prompt builder.
+// Applies theme styles to the text input and loads initial suggestions.
+// (ai generated comment)
+func newInputModel(pb *promptBuilder) *inputModel {
+	theme := pb.getTheme()
+	ti := textinput.New()
+	ti.Prompt = pb.getPrompt()
+	ti.Placeholder = pb.getPlaceholder()
+	ti.ShowSuggestions = true
+	ti.PromptStyle = theme.Focused.TextInput.Prompt
+	ti.TextStyle = theme.Focused.TextInput.Text
+	ti.PlaceholderStyle = theme.Focused.TextInput.Placeholder
+	ti.CompletionStyle = theme.Focused.TextInput.Placeholder
+	ti.Cursor.Style = theme.Focused.TextInput.Cursor
+	ti.Cursor.TextStyle = theme.Focused.TextInput.CursorText
+	if w := pb.getWidth(); w > 0 {
+		ti.Width = max(w-len(ti.Prompt)-3, 1)
+	}
+	ti.SetValue(pb.getDefaultValue())
+	ti.Focus()
+
+	im := inputModel{
+		title:        pb.getTitle(),
+		description:  pb.getDescription(),
+		textInput:    ti,
+		provider:     pb.getSuggestionProvider(),
+		validator:    pb.getStringValidator(),
+		async:        newAsyncValidation(pb.getAsyncStringValidator(), pb.getValidationDebounce(), theme).withHooks(pb.hooks()).withMessages(pb.localizer()),
+		hooks:        pb.hooks(),
+		warnings:     warningState{confirm: pb.getConfirmWarnings()},
+		cursor:       defaultCursor,
+		history:      pb.historyEntries(),
+		historyIndex: -1,
+		theme:        theme,
+		msgs:         pb.localizer(),
+		width:        pb.getWidth(),
+		height:       pb.getHeight(),
+		id:           pb.id,
+	}
+	im.refreshSuggestions()
+	im.warnings.show(im.validator(ti.Value()))
+	return &im
+}
+
+// Init initializes the input model as required by the Bubble Tea Model interface.
+// Starts cursor blinking.
+// (ai generated comment)
+func (im inputModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// replayMode returns the model with a steady cursor and checks without debounce for session replay.
+// (ai generated comment)
+func (im inputModel) replayMode() tea.Model {
+	steadyCursor(&im.textInput)
+	im.async = im.async.replayMode()
+	return im
+}
+
+// Update handles messages and updates the input model state.
+// Enter validates and submits, tab accepts the highlighted suggestion,
+// up/down move through the dropdown or, without matching suggestions, recall history,
+// and any other key edits the text.
+// With asynchronous validator submit waits until the check of the text passes.
+// (ai generated comment)
+func (im inputModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if im.done {
+		return im, nil
+	}
+	if handled, submit, cmd := im.async.update(msg); handled {
+		if submit {
+			return im.submit(im.async.value)
+		}
+		return im, cmd
+	}
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "ctrl+c":
+			im.async.stop()
+			im.done = true
+			im.err = tea.ErrInterrupted
+			im.hooks.cancel(im.err)
+			return im, im.resultCmd()
+		case "esc":
+			im.async.stop()
+			im.done = true
+			im.err = im.msgs.wrap(ErrCanceled, "input canceled")
+			im.hooks.cancel(im.err)
+			return im, im.resultCmd()
+		case "enter":
+			value := im.textInput.Value()
+			proceed, err := im.warnings.check(im.validator(value))
+			if err != nil {
+				im.valErr = im.hooks.invalid(value, err)
+				return im, nil
+			}
+			if !proceed {
+				return im, nil
+			}
+			if ready, cmd := im.async.requestSubmit(value); !ready {
+				return im, cmd
+			}
+			return im.submit(value)
+		case "up", "down":
+			if im.recallsHistory() {
+				return im, im.recall(msg.String() == "up")
+			}
+		}
+		im.valErr = nil
+		im.historyIndex = -1
+	}
+	before := im.textInput.Value()
+	var cmd tea.Cmd
+	im.textInput, cmd = im.textInput.Update(msg)
+	if im.textInput.Value() != before {
+		cmd = tea.Batch(cmd, im.textChanged())
+	}
+	return im, cmd
+}
+
+// textChanged refreshes suggestions, warnings and asynchronous check after text change.
+// (ai generated comment)
+func (im *inputModel) textChanged() tea.Cmd {
+	im.refreshSuggestions()
+	im.warnings.show(im.validator(im.textInput.Value()))
+	im.hooks.change(im.textInput.Value())
+	return im.async.change(im.textInput.Value())
+}
+
+// recallsHistory reports whether up/down keys navigate history instead of suggestions.
+// (ai generated comment)
+func (im *inputModel) recallsHistory() bool {
+	if len(im.history) == 0 {
+		return false
+	}
+	return im.historyIndex >= 0 || len(im.textInput.MatchedSuggestions()) == 0
+}
+
+// recall replaces the text with an older (or newer) history entry.
+// Moving past the most recent entry restores the text typed before recall.
+// (ai generated comment)
+func (im *inputModel) recall(older bool) tea.Cmd {
+	index := im.historyIndex + 1
+	if !older {
+		index = im.historyIndex - 1
+	}
+	if index >= len(im.history) || index < -1 {
+		return nil
+	}
+	if im.historyIndex == -1 {
+		im.draft = im.textInput.Value()
+	}
+	im.historyIndex = index
+	text := im.draft
+	if index >= 0 {
+		text = im.history[index]
+	}
+	im.valErr = nil
+	im.textInput.SetValue(text)
+	im.textInput.CursorEnd()
+	return im.textChanged()
+}
+
+// submit completes the input with the value.
+// (ai generated comment)
+func (im inputModel) submit(value string) (tea.Model, tea.Cmd) {
+	if err := im.hooks.submit(value); err != nil {
+		im.valErr = err
+		return im, nil
+	}
+	im.value = value
+	im.done = true
+	return im, im.resultCmd()
+}
+
+// resultCmd sends the submitted text or the error as result of the prompt.
+// (ai generated comment)
+func (im inputModel) resultCmd() tea.Cmd {
+	return sendResult(im.id, im.value, im.err)
+}
+
+// refreshSuggestions queries the provider with the current text and passes result to the text input.
+// (ai generated comment)
+func (im *inputModel) refreshSuggestions() {
+	if im.provider == nil {
+		return
+	}
+	im.textInput.SetSuggestions(im.provider(im.textInput.Value()))
+}
+
+// dropdownHeight calculates how many suggestions can be displayed at once.
+// (ai generated comment)
+func (im *inputModel) dropdownHeight() int {
+	if im.height <= 0 {
+		return defaultDropdownHeight
+	}
+	return max(im.height-6, 1)
+}
+
+// viewTitle renders the title section of the input prompt.
+// (ai generated comment)
+func (im *inputModel) viewTitle() string {
+	if im.title == "" {
+		return ""
+	}
+	return im.theme.Focused.TextInput.Prompt.Render("┃ ") + im.theme.Focused.Title.Render(im.title)
+}
+
+// viewDescription renders the description section of the input prompt.
+// Returns empty string if no description is set.
+// (ai generated comment)
+func (im *inputModel) viewDescription() string {
+	if im.description == "" {
+		return ""
+	}
+	return startLine(im.theme) + im.theme.Focused.Description.Render(im.description)
+}
+
+// viewDropdown renders matched suggestions around the highlighted one.
+// Returns empty string when nothing matches the current text.
+// (ai generated comment)
+func (im *inputModel) viewDropdown() string {
+	matched := im.textInput.MatchedSuggestions()
+	if len(matched) == 0 {
+		return ""
+	}
+	current := im.textInput.CurrentSuggestionIndex()
+	visible := im.dropdownHeight()
+	start := max(min(current-visible+1, len(matched)-visible), 0)
+	if current < visible {
+		start = 0
+	}
+	end := min(start+visible, len(matched))
+	s := ""
+	for i := start; i < end; i++ {
+		cursStr := im.cursor.unselected
+		if i == current {
+			cursStr = im.cursor.selected
+			s += startLine(im.theme) + im.theme.Focused.SelectedOption.Render(cursStr+matched[i])
+			continue
+		}
+		s += startLine(im.theme) + im.theme.Focused.Option.Render(cursStr+matched[i])
+	}
+	if len(matched) > visible {
+		s += startLine(im.theme) + im.theme.Help.ShortKey.Render(im.msgs.plural("%v suggestion|%v suggestions", len(matched), len(matched)))
+	}
+	return s
+}
+
+// viewError renders the last validation error.
+// (ai generated comment)
+func (im *inputModel) viewError() string {
+	if im.valErr == nil {
+		return ""
+	}
+	return startLine(im.theme) + im.theme.Focused.ErrorMessage.Render(im.valErr.Error())
+}
+
+// viewHelp renders the help section with key binding instructions.
+// (ai generated comment)
+func (im *inputModel) viewHelp() string {
+	binds := []key.Binding{}
+	if im.provider != nil {
+		binds = append(binds,
+			key.NewBinding(key.WithHelp("tab", "complete")),
+			key.NewBinding(key.WithHelp("↑/↓", "choose suggestion")),
+		)
+	}
+	if len(im.history) > 0 && im.provider == nil {
+		binds = append(binds, key.NewBinding(key.WithHelp("↑/↓", "history")))
+	}
+	binds = append(binds,
+		key.NewBinding(key.WithHelp("enter", "submit")),
+		key.NewBinding(key.WithHelp("esc", "cancel")),
+	)
+	return renderHelp(im.theme, im.msgs, binds)
+}
+
+// View renders the complete input prompt interface.
+// Returns empty string if the input is completed.
+// (ai generated comment)
+func (im inputModel) View() string {
+	if im.done {
+		return ""
+	}
+	s := im.viewTitle()
+	s += im.viewDescription()
+	s += startLine(im.theme) + im.textInput.View()
+	s += im.viewDropdown()
+	s += im.viewError()
+	s += im.warnings.view(im.theme, im.msgs)
+	s += im.async.view()
+	s += im.viewHelp()
+	return s
+}
+
+// runInputModel runs the autocomplete input model and returns the submitted text.
+// (ai generated comment)
+func runInputModel(pb *promptBuilder) (string, error) {
+	resultState, err := runModel(pb, newInputModel(pb))
+	if err != nil {
+		return "", err
+	}
+	if im, ok := resultState.(inputModel); ok {
+		return im.value, im.err
+	}
+	return "", fmt.Errorf("unexpected endpoint reached")
+}
@@ -0,0 +1,108 @@
+package prompt
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// newTestInputModel creates an input model from the given options for tests
+func newTestInputModel(opts ...PromptOption) inputModel {
+	pb := &promptBuilder{
+		promptType:       ptInput,
+		settings:         make(map[any]any),
+		defaultsRegistry: defaultRegistry(),
+	}
+	for _, modify := range opts {
+		modify(pb)
+	}
+	return *newInputModel(pb)
+}
+
+// typeText sends every rune of text to the model as a key press
+func typeText(m tea.Model, text string) tea.Model {
+	for _, r := range text {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return m
+}
+
+// TestInputModelSuggestions tests that typing refreshes matched suggestions
+func TestInputModelSuggestions(t *testing.T) {
+	var m tea.Model = newTestInputModel(WithSuggestions("apple", "apricot", "banana"))
+
+	m = typeText(m, "ap")
+	im := m.(inputModel)
+	if got := im.textInput.MatchedSuggestions(); len(got) != 2 {
+		t.Fatalf("MatchedSuggestions() = %v, want 2 items", got)
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	im = m.(inputModel)
+	if im.textInput.Value() != "apricot" {
+		t.Errorf("value after tab = %q, want %q", im.textInput.Value(), "apricot")
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	im = m.(inputModel)
+	if !im.done || im.value != "apricot" {
+		t.Errorf("submitted = %v/%q, want true/%q", im.done, im.value, "apricot")
+	}
+}
+
+// TestInputModelValidation tests that a failing validator blocks submit
+func TestInputModelValidation(t *testing.T) {
+	var m tea.Model = newTestInputModel(
+		WithSuggestions("10", "abc"),
+		WithStringValidator(Integer),
+	)
+
+	m = typeText(m, "abc")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	im := m.(inputModel)
+	if im.done {
+		t.Error("input should not be submitted with invalid value")
+	}
+	if im.valErr == nil {
+		t.Error("validation error should be shown")
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	im = m.(inputModel)
+	if !im.done || im.err == nil {
+		t.Error("esc should cancel input with error")
+	}
+	if im.View() != "" {
+		t.Error("canceled input should render empty view")
+	}
+}
+
+// TestInputModelDropdown tests that dropdown is limited to prompt height
+func TestInputModelDropdown(t *testing.T) {
+	var m tea.Model = newTestInputModel(
+		WithSuggestionProvider(func(prefix string) []string {
+			return []string{prefix + "1", prefix + "2", prefix + "3", prefix + "4"}
+		}),
+		WithHeight(8),
+	)
+	m = typeText(m, "x")
+	im := m.(inputModel)
+	if im.dropdownHeight() != 2 {
+		t.Errorf("dropdownHeight() = %d, want 2", im.dropdownHeight())
+	}
+	if im.viewDropdown() == "" {
+		t.Error("dropdown should be rendered when suggestions match")
+	}
+}
+
+// TestInputModelDefaultValue tests that input starts with the default text
+func TestInputModelDefaultValue(t *testing.T) {
+	im := newTestInputModel(WithSuggestions("apple", "apricot"), WithDefaultValue("apr"))
+	if im.textInput.Value() != "apr" {
+		t.Errorf("Value() = %q, want apr", im.textInput.Value())
+	}
+	if got := im.textInput.MatchedSuggestions(); len(got) != 1 {
+		t.Errorf("MatchedSuggestions() = %v, want 1 item", got)
+	}
+}
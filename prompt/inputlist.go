@@ -0,0 +1,293 @@
+package prompt
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+)
+
+// listModel represents the Bubble Tea model for the list input prompt.
+// Each entered line becomes a chip; an empty line submits the collected list.
+// (ai generated comment)
+type listModel struct {
+	title       string // Main title displayed at the top
+	description string // Additional description text
+
+	textInput     textinput.Model         // Line editor for the next value
+	values        []string                // Collected values (chips)
+	validator     StringValidatorFunc     // Validator applied to each value
+	listValidator StringListValidatorFunc // Validator applied to the whole list
+	unique        bool                    // Whether duplicates are forbidden
+	hooks         hooks                   // Lifecycle callbacks
+
+	theme  *huh.Theme // Visual theme for consistent styling
+	msgs   localizer  // Translator of built-in strings
+	width  int        // Prompt width
+	height int        // Prompt height
+	done   bool       // Whether input is completed
+	err    error      // Error state if input fails
+	valErr error      // Last validation error shown under the field
+	id     int        // ID sent with the result message
+
+	warnings warningState // Validation warning of added values or the whole list
+}
+
+// newListModel creates and initializes a new list input model from a configured prompt builder.
+// Returns the list model or an error if initialization fails.
+// (ai generated comment)
+func newListModel(pb *promptBuilder) (*listModel, error) {
+	theme := pb.getTheme()
+	ti := textinput.New()
+	ti.Prompt = pb.getPrompt()
+	ti.Placeholder = pb.getPlaceholder()
+	ti.PromptStyle = theme.Focused.TextInput.Prompt
+	ti.TextStyle = theme.Focused.TextInput.Text
+	ti.PlaceholderStyle = theme.Focused.TextInput.Placeholder
+	ti.Cursor.Style = theme.Focused.TextInput.Cursor
+	ti.Cursor.TextStyle = theme.Focused.TextInput.CursorText
+	if w := pb.getWidth(); w > 0 {
+		ti.Width = max(w-len(ti.Prompt)-3, 1)
+	}
+	ti.Focus()
+
+	lm := listModel{
+		title:         pb.getTitle(),
+		description:   pb.getDescription(),
+		textInput:     ti,
+		values:        append([]string{}, pb.getDefaultValues()...),
+		validator:     pb.getStringValidator(),
+		listValidator: pb.getStringListValidator(),
+		unique:        pb.getUniqueValues(),
+		warnings:      warningState{confirm: pb.getConfirmWarnings()},
+		theme:         theme,
+		msgs:          pb.localizer(),
+		width:         pb.getWidth(),
+		height:        pb.getHeight(),
+		hooks:         pb.hooks(),
+		id:            pb.id,
+	}
+	return &lm, nil
+}
+
+// resultCmd sends the collected values or the error as result of the prompt.
+// (ai generated comment)
+func (lm listModel) resultCmd() tea.Cmd {
+	return sendResult(lm.id, lm.values, lm.err)
+}
+
+// Init initializes the list model as required by the Bubble Tea Model interface.
+// (ai generated comment)
+func (lm listModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// replayMode returns the model with a steady cursor for session replay.
+// (ai generated comment)
+func (lm listModel) replayMode() tea.Model {
+	steadyCursor(&lm.textInput)
+	return lm
+}
+
+// Update handles messages and updates the list model state.
+// Enter adds the current line as a value or submits the list when the line is empty,
+// backspace on an empty line moves the last value back into the editor,
+// pasted text is split on commas and newlines.
+// (ai generated comment)
+func (lm listModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if lm.done {
+		return lm, nil
+	}
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case msg.String() == "ctrl+c":
+			lm.done = true
+			lm.err = tea.ErrInterrupted
+			lm.hooks.cancel(lm.err)
+			return lm, lm.resultCmd()
+		case msg.String() == "esc":
+			lm.done = true
+			lm.err = lm.msgs.wrap(ErrCanceled, "input canceled")
+			lm.hooks.cancel(lm.err)
+			return lm, lm.resultCmd()
+		case msg.String() == "enter":
+			value := strings.TrimSpace(lm.textInput.Value())
+			if value != "" {
+				lm.addValues([]string{value})
+				return lm, nil
+			}
+			proceed, err := lm.warnings.check(lm.listValidator(lm.values))
+			if err != nil {
+				lm.valErr = lm.hooks.invalid(lm.values, err)
+			}
+			if !proceed {
+				return lm, nil
+			}
+			if err := lm.hooks.submit(lm.values); err != nil {
+				lm.valErr = err
+				return lm, nil
+			}
+			lm.done = true
+			return lm, lm.resultCmd()
+		case msg.String() == "backspace" && lm.textInput.Value() == "" && len(lm.values) > 0:
+			last := lm.values[len(lm.values)-1]
+			lm.values = lm.values[:len(lm.values)-1]
+			lm.textInput.SetValue(last)
+			lm.textInput.CursorEnd()
+			lm.valErr = nil
+			lm.hooks.change(lm.values)
+			return lm, nil
+		case msg.Type == tea.KeyRunes && strings.ContainsAny(string(msg.Runes), ",\n\r"):
+			lm.addValues(splitListInput(lm.textInput.Value() + string(msg.Runes)))
+			return lm, nil
+		}
+		lm.valErr = nil
+	}
+	var cmd tea.Cmd
+	lm.textInput, cmd = lm.textInput.Update(msg)
+	return lm, cmd
+}
+
+// addValues validates and appends values to the list.
+// Stops at the first invalid value, leaving it and the remaining values in the editor.
+// Values with warnings are added and the last warning is shown.
+// (ai generated comment)
+func (lm *listModel) addValues(values []string) {
+	lm.valErr = nil
+	lm.warnings.show(nil)
+	added := len(lm.values)
+	for i, value := range values {
+		err := lm.checkValue(value)
+		if isBlocking(err) {
+			lm.valErr = lm.hooks.invalid(value, fmt.Errorf("%v: %v", value, err))
+			lm.textInput.SetValue(strings.Join(values[i:], ", "))
+			lm.textInput.CursorEnd()
+			break
+		}
+		if err != nil {
+			lm.warnings.show(Warn("%v: %v", value, err))
+		}
+		lm.values = append(lm.values, value)
+	}
+	if len(lm.values) > added {
+		lm.hooks.change(lm.values)
+	}
+	if lm.valErr == nil {
+		lm.textInput.Reset()
+	}
+}
+
+// checkValue validates a single value and checks for duplicates if forbidden.
+// (ai generated comment)
+func (lm *listModel) checkValue(value string) error {
+	if lm.unique && slices.Contains(lm.values, value) {
+		return fmt.Errorf("duplicate value")
+	}
+	return lm.validator(value)
+}
+
+// splitListInput splits text on commas and newlines, dropping empty parts.
+// (ai generated comment)
+func splitListInput(text string) []string {
+	parts := strings.FieldsFunc(text, func(r rune) bool {
+		return r == ',' || r == '\n' || r == '\r'
+	})
+	values := []string{}
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			values = append(values, part)
+		}
+	}
+	return values
+}
+
+// viewTitle renders the title section of the list prompt.
+// (ai generated comment)
+func (lm *listModel) viewTitle() string {
+	if lm.title == "" {
+		return ""
+	}
+	return lm.theme.Focused.TextInput.Prompt.Render("┃ ") + lm.theme.Focused.Title.Render(lm.title)
+}
+
+// viewDescription renders the description section of the list prompt.
+// (ai generated comment)
+func (lm *listModel) viewDescription() string {
+	if lm.description == "" {
+		return ""
+	}
+	return startLine(lm.theme) + lm.theme.Focused.Description.Render(lm.description)
+}
+
+// viewChips renders collected values as chips, wrapping lines to prompt width.
+// (ai generated comment)
+func (lm *listModel) viewChips() string {
+	if len(lm.values) == 0 {
+		return ""
+	}
+	s := ""
+	line := ""
+	lineLen := 0
+	for _, value := range lm.values {
+		chip := "[" + value + "]"
+		if lm.width > 0 && lineLen > 0 && lineLen+glyphsLen(chip)+1 > lm.width-2 {
+			s += startLine(lm.theme) + line
+			line, lineLen = "", 0
+		}
+		if lineLen > 0 {
+			line += " "
+			lineLen++
+		}
+		line += lm.theme.Focused.SelectedOption.Render(chip)
+		lineLen += glyphsLen(chip)
+	}
+	return s + startLine(lm.theme) + line
+}
+
+// viewError renders the last validation error.
+// (ai generated comment)
+func (lm *listModel) viewError() string {
+	if lm.valErr == nil {
+		return ""
+	}
+	return startLine(lm.theme) + lm.theme.Focused.ErrorMessage.Render(lm.valErr.Error())
+}
+
+// viewSummary renders the number of collected values.
+// (ai generated comment)
+func (lm *listModel) viewSummary() string {
+	return startLine(lm.theme) + startLine(lm.theme) + lm.theme.Focused.Option.Render(lm.msgs.plural("%v value entered|%v values entered", len(lm.values), len(lm.values)))
+}
+
+// viewHelp renders the help section with key binding instructions.
+// (ai generated comment)
+func (lm *listModel) viewHelp() string {
+	return renderHelp(lm.theme, lm.msgs, []key.Binding{
+		key.NewBinding(key.WithHelp("enter", "add")),
+		key.NewBinding(key.WithHelp("enter on empty line", "submit")),
+		key.NewBinding(key.WithHelp("backspace", "edit last")),
+		key.NewBinding(key.WithHelp("esc", "cancel")),
+	})
+}
+
+// View renders the complete list prompt interface.
+// Returns empty string if the input is completed.
+// (ai generated comment)
+func (lm listModel) View() string {
+	if lm.done {
+		return ""
+	}
+	s := lm.viewTitle()
+	s += lm.viewDescription()
+	s += lm.viewChips()
+	s += startLine(lm.theme) + lm.textInput.View()
+	s += lm.viewError()
+	s += lm.warnings.view(lm.theme, lm.msgs)
+	s += lm.viewSummary()
+	s += lm.viewHelp()
+	return s
+}
@@ -0,0 +1,110 @@
+package prompt
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// newTestListModel creates a list model from the given options for tests
+func newTestListModel(t *testing.T, opts ...PromptOption) tea.Model {
+	lm, err := newListModel(newTestBuilder(t, ptInputList, opts...))
+	if err != nil {
+		t.Fatalf("newListModel() error = %v", err)
+	}
+	return *lm
+}
+
+// TestListModelAddAndSubmit tests adding values and submitting with an empty line
+func TestListModelAddAndSubmit(t *testing.T) {
+	m := newTestListModel(t)
+
+	m = typeText(m, "alpha")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = typeText(m, "beta")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	lm := m.(listModel)
+	if !lm.done {
+		t.Fatal("enter on empty line should submit the list")
+	}
+	if !reflect.DeepEqual(lm.values, []string{"alpha", "beta"}) {
+		t.Errorf("values = %v, want [alpha beta]", lm.values)
+	}
+}
+
+// TestListModelEditLast tests that backspace on empty line edits the last value
+func TestListModelEditLast(t *testing.T) {
+	m := newTestListModel(t)
+
+	m = typeText(m, "one")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+
+	lm := m.(listModel)
+	if len(lm.values) != 0 {
+		t.Errorf("values = %v, want empty", lm.values)
+	}
+	if lm.textInput.Value() != "one" {
+		t.Errorf("editor value = %q, want %q", lm.textInput.Value(), "one")
+	}
+}
+
+// TestListModelPaste tests that pasted text is split into values
+func TestListModelPaste(t *testing.T) {
+	m := newTestListModel(t, WithUniqueValues(true), WithStringValidator(Integer))
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1, 2\n3,,2,x"), Paste: true})
+	lm := m.(listModel)
+	if !reflect.DeepEqual(lm.values, []string{"1", "2", "3"}) {
+		t.Errorf("values = %v, want [1 2 3]", lm.values)
+	}
+	if lm.valErr == nil {
+		t.Error("duplicate value should produce an error")
+	}
+	if lm.textInput.Value() != "2, x" {
+		t.Errorf("editor value = %q, want %q", lm.textInput.Value(), "2, x")
+	}
+}
+
+// TestListModelListValidator tests that list validator blocks submit
+func TestListModelListValidator(t *testing.T) {
+	m := newTestListModel(t, WithStringListValidator(func(values []string) error {
+		if len(values) < 2 {
+			return fmt.Errorf("at least 2 values required")
+		}
+		return nil
+	}))
+
+	m = typeText(m, "only")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	lm := m.(listModel)
+	if lm.done {
+		t.Error("list should not be submitted when list validator fails")
+	}
+	if lm.valErr == nil {
+		t.Error("list validation error should be shown")
+	}
+}
+
+// TestSplitListInput tests splitting of comma and newline separated text
+func TestSplitListInput(t *testing.T) {
+	got := splitListInput(" a ,b\r\nc,, ")
+	if !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("splitListInput() = %v, want [a b c]", got)
+	}
+}
+
+// TestListModelDefaultValues tests that default values are listed initially
+func TestListModelDefaultValues(t *testing.T) {
+	m := newTestListModel(t, WithDefaultValues("a", "b"))
+	m = pressKeys(m, tea.KeyEnter)
+	lm := m.(listModel)
+	if !lm.done || !reflect.DeepEqual(lm.values, []string{"a", "b"}) {
+		t.Errorf("values = %v, want a,b", lm.values)
+	}
+}
@@ -1,12 +1,31 @@
 package prompt
 
+import (
+	"bufio"
+	"cmp"
+	"fmt"
+	"io"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
 // Item represents a selectable item in list-based prompts like select, multi-select, and search.
 // It contains a display key and an optional payload for storing additional data.
 // The payload can be any type, making Items flexible for various use cases.
 // (ai generated comment)
 type Item struct {
-	key     string // Display text shown to the user in prompts
-	payload any    // Optional associated data (can be any type)
+	key            string          // Display text shown to the user in prompts
+	payload        any             // Optional associated data (can be any type)
+	description    string          // Dimmed second line shown under the key
+	disabled       bool            // Whether item is shown but can not be selected
+	disabledReason string          // Explanation shown next to disabled item
+	group          string          // Group header the item is listed under
+	hint           string          // Badge shown after the key
+	style          *lipgloss.Style // Style override for the key
 }
 
 // NewItem creates a new Item with the specified key and optional payload.
@@ -40,3 +59,196 @@ func (i Item) Key() string {
 func (i Item) Payload() any {
 	return i.payload
 }
+
+// WithDescription sets the description shown as a dimmed line under the item key.
+// Returns the item to allow chaining.
+// (ai generated comment)
+func (i *Item) WithDescription(description string) *Item {
+	i.description = description
+	return i
+}
+
+// WithDisabled marks the item as disabled: it is rendered but can not be selected.
+// The reason is shown next to the item; it may be empty.
+// Returns the item to allow chaining.
+// (ai generated comment)
+func (i *Item) WithDisabled(reason string) *Item {
+	i.disabled = true
+	i.disabledReason = reason
+	return i
+}
+
+// WithGroup sets the group header the item is listed under.
+// Consecutive items of the same group share one header.
+// Returns the item to allow chaining.
+// (ai generated comment)
+func (i *Item) WithGroup(group string) *Item {
+	i.group = group
+	return i
+}
+
+// WithHint sets the badge shown after the item key.
+// Returns the item to allow chaining.
+// (ai generated comment)
+func (i *Item) WithHint(hint string) *Item {
+	i.hint = hint
+	return i
+}
+
+// WithStyle overrides the theme style used to render the item key.
+// Returns the item to allow chaining.
+// (ai generated comment)
+func (i *Item) WithStyle(style lipgloss.Style) *Item {
+	i.style = &style
+	return i
+}
+
+// Description returns the description of the item.
+// (ai generated comment)
+func (i Item) Description() string {
+	return i.description
+}
+
+// Disabled reports whether the item can not be selected.
+// (ai generated comment)
+func (i Item) Disabled() bool {
+	return i.disabled
+}
+
+// DisabledReason returns the explanation why the item is disabled.
+// (ai generated comment)
+func (i Item) DisabledReason() string {
+	return i.disabledReason
+}
+
+// Group returns the group header the item is listed under.
+// (ai generated comment)
+func (i Item) Group() string {
+	return i.group
+}
+
+// Hint returns the badge shown after the item key.
+// (ai generated comment)
+func (i Item) Hint() string {
+	return i.hint
+}
+
+// matchesItem reports whether value identifies the item: as the item itself,
+// as a string equal to the item key, or as a value equal to the item payload.
+// (ai generated comment)
+func matchesItem(item *Item, value any) bool {
+	switch v := value.(type) {
+	case *Item:
+		return v == item
+	case string:
+		if v == item.key {
+			return true
+		}
+	}
+	return reflect.DeepEqual(item.payload, value)
+}
+
+// matchItems returns enabled items identified by any of the values, in list order.
+// (ai generated comment)
+func matchItems(items []*Item, values []any) []*Item {
+	matched := []*Item{}
+	for _, item := range items {
+		if item.disabled {
+			continue
+		}
+		for _, value := range values {
+			if matchesItem(item, value) {
+				matched = append(matched, item)
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// CreateItem creates a new Item from any value.
+// The key is the default text representation of the value, the payload is the value itself.
+// (ai generated comment)
+func CreateItem(obj any) *Item {
+	return &Item{key: fmt.Sprint(obj), payload: obj}
+}
+
+// NewItemList creates a list of Items from arbitrary values using CreateItem.
+// (ai generated comment)
+func NewItemList(objects ...any) []*Item {
+	list := []*Item{}
+	for _, obj := range objects {
+		list = append(list, CreateItem(obj))
+	}
+	return list
+}
+
+// ItemsFromSlice creates a list of Items from a slice, preserving its order.
+// Keys are produced by the key function, payloads are the original values.
+// (ai generated comment)
+func ItemsFromSlice[T any](values []T, key func(T) string) []*Item {
+	list := []*Item{}
+	for _, v := range values {
+		list = append(list, &Item{key: key(v), payload: v})
+	}
+	return list
+}
+
+// ItemsFromMap creates a list of Items from a map sorted by map keys.
+// Keys are text representations of map keys, payloads are map values.
+// (ai generated comment)
+func ItemsFromMap[K cmp.Ordered, V any](m map[K]V) []*Item {
+	list := []*Item{}
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		list = append(list, &Item{key: fmt.Sprint(k), payload: m[k]})
+	}
+	return list
+}
+
+// ItemsFromStringers creates a list of Items from values implementing fmt.Stringer.
+// Keys are results of String method, payloads are the original values.
+// (ai generated comment)
+func ItemsFromStringers[T fmt.Stringer](values ...T) []*Item {
+	return ItemsFromSlice(values, T.String)
+}
+
+// enumValue is a constraint for integer-based enum-like constant types.
+// (ai generated comment)
+type enumValue interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// ItemsFromEnum creates a list of Items for all enum constants from first to last inclusive.
+// Keys are text representations of constants (String method is used if defined),
+// payloads are the constants themselves.
+// (ai generated comment)
+func ItemsFromEnum[E enumValue](first, last E) []*Item {
+	list := []*Item{}
+	for e := first; e <= last; e++ {
+		list = append(list, &Item{key: fmt.Sprint(e), payload: e})
+		if e == last {
+			break
+		}
+	}
+	return list
+}
+
+// ItemsFromReader creates a list of Items from non-empty lines of a reader.
+// Trailing carriage returns are dropped; keys and payloads are the lines.
+// Returns an error if reading fails.
+// (ai generated comment)
+func ItemsFromReader(r io.Reader) ([]*Item, error) {
+	list := []*Item{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		list = append(list, &Item{key: line, payload: line})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read items: %v", err)
+	}
+	return list, nil
+}
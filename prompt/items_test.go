@@ -1,6 +1,7 @@
 package prompt
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -71,3 +72,105 @@ func TestItemMethods(t *testing.T) {
 		t.Errorf("Payload() = %v, want %v", item.Payload(), "test_payload")
 	}
 }
+
+// testColor is an enum-like type used in item constructor tests
+type testColor uint8
+
+const (
+	testRed testColor = iota
+	testGreen
+	testBlue
+)
+
+func (c testColor) String() string {
+	return [...]string{"red", "green", "blue"}[c]
+}
+
+// assertItems checks keys and payloads of an item list
+func assertItems(t *testing.T, got []*Item, keys []string, payloads []any) {
+	t.Helper()
+	if len(got) != len(keys) {
+		t.Fatalf("got %v items, want %v", len(got), len(keys))
+	}
+	for i, item := range got {
+		if item.Key() != keys[i] || item.Payload() != payloads[i] {
+			t.Errorf("item %v = %q/%v, want %q/%v", i, item.Key(), item.Payload(), keys[i], payloads[i])
+		}
+	}
+}
+
+// TestItemConstructors tests building item lists from various sources
+func TestItemConstructors(t *testing.T) {
+	t.Run("CreateItem", func(t *testing.T) {
+		assertItems(t, []*Item{CreateItem(42)}, []string{"42"}, []any{42})
+	})
+	t.Run("NewItemList", func(t *testing.T) {
+		assertItems(t, NewItemList("a", 2, testBlue), []string{"a", "2", "blue"}, []any{"a", 2, testBlue})
+	})
+	t.Run("ItemsFromSlice", func(t *testing.T) {
+		got := ItemsFromSlice([]int{3, 1}, func(i int) string { return strings.Repeat("*", i) })
+		assertItems(t, got, []string{"***", "*"}, []any{3, 1})
+	})
+	t.Run("ItemsFromMap", func(t *testing.T) {
+		got := ItemsFromMap(map[int]string{10: "ten", 2: "two", 7: "seven"})
+		assertItems(t, got, []string{"2", "7", "10"}, []any{"two", "seven", "ten"})
+	})
+	t.Run("ItemsFromStringers", func(t *testing.T) {
+		assertItems(t, ItemsFromStringers(testGreen, testRed), []string{"green", "red"}, []any{testGreen, testRed})
+	})
+	t.Run("ItemsFromEnum", func(t *testing.T) {
+		got := ItemsFromEnum(testRed, testBlue)
+		assertItems(t, got, []string{"red", "green", "blue"}, []any{testRed, testGreen, testBlue})
+	})
+	t.Run("ItemsFromEnum full range", func(t *testing.T) {
+		if got := ItemsFromEnum(uint8(0), uint8(255)); len(got) != 256 {
+			t.Errorf("got %v items, want 256", len(got))
+		}
+	})
+	t.Run("ItemsFromReader", func(t *testing.T) {
+		got, err := ItemsFromReader(strings.NewReader("one\r\n\ntwo\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertItems(t, got, []string{"one", "two"}, []any{"one", "two"})
+	})
+}
+
+// TestItemMetadata tests item metadata setters
+func TestItemMetadata(t *testing.T) {
+	item := NewItem("a").WithDescription("desc").WithDisabled("why").WithGroup("g").WithHint("h")
+	if item.Description() != "desc" || !item.Disabled() || item.DisabledReason() != "why" || item.Group() != "g" || item.Hint() != "h" {
+		t.Errorf("unexpected metadata: %+v", item)
+	}
+}
+
+// TestMatchItems tests identifying items by key, payload and pointer
+func TestMatchItems(t *testing.T) {
+	type point struct{ x, y int }
+	a, b, c := NewItem("a", 1), NewItem("b", point{1, 2}), NewItem("c", []int{3})
+	items := []*Item{a, b, c, NewItem("d").WithDisabled("")}
+	tests := []struct {
+		name   string
+		values []any
+		want   []*Item
+	}{
+		{"by key", []any{"c", "a"}, []*Item{a, c}},
+		{"by payload", []any{point{1, 2}, []int{3}}, []*Item{b, c}},
+		{"by item", []any{b}, []*Item{b}},
+		{"disabled ignored", []any{"d"}, []*Item{}},
+		{"no match", []any{2, "z"}, []*Item{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := matchItems(items, tt.values)
+			if len(got) != len(tt.want) {
+				t.Fatalf("matchItems() = %v items, want %v", len(got), len(tt.want))
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("item %v = %v, want %v", i, got[i].Key(), tt.want[i].Key())
+				}
+			}
+		})
+	}
+}
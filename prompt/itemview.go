@@ -0,0 +1,107 @@
+package prompt
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// itemKeyStyle returns the style used to render the item key.
+// Disabled items are dimmed, items with style override use it, others use base style.
+// (ai generated comment)
+func itemKeyStyle(theme *huh.Theme, item *Item, base lipgloss.Style) lipgloss.Style {
+	switch {
+	case item.disabled:
+		return theme.Focused.TextInput.Placeholder
+	case item.style != nil:
+		return *item.style
+	}
+	return base
+}
+
+// renderItemSuffix renders the hint badge and disabled reason shown after the item key.
+// (ai generated comment)
+func renderItemSuffix(theme *huh.Theme, msgs localizer, item *Item) string {
+	s := ""
+	if item.hint != "" {
+		s += " " + theme.Focused.Description.Render("["+item.hint+"]")
+	}
+	if item.disabled {
+		reason := msgs.t("disabled")
+		if item.disabledReason != "" {
+			reason = item.disabledReason
+		}
+		s += " " + theme.Focused.TextInput.Placeholder.Render("("+reason+")")
+	}
+	return s
+}
+
+// renderItemDescription renders the item description as a dimmed line under the key.
+// Returns empty string if the item has no description.
+// (ai generated comment)
+func renderItemDescription(theme *huh.Theme, item *Item, indent string) string {
+	if item.description == "" {
+		return ""
+	}
+	return startLine(theme) + indent + theme.Focused.Description.Render(item.description)
+}
+
+// renderGroupHeader renders the header line starting the group of items at index i.
+// Header is shown when group changes and for the first visible item.
+// Returns empty string if no header is needed.
+// (ai generated comment)
+func renderGroupHeader(theme *huh.Theme, items []*Item, i, firstVisible int) string {
+	group := items[i].group
+	if group == "" {
+		return ""
+	}
+	if i != firstVisible && items[i-1].group == group {
+		return ""
+	}
+	return startLine(theme) + theme.Focused.NoteTitle.Render(group)
+}
+
+// itemLines returns the number of lines the item at index i takes in a list starting at index first:
+// the key line, the group header and the description lines.
+// (ai generated comment)
+func itemLines(items []*Item, i, first int) int {
+	item := items[i]
+	n := 1
+	if item.group != "" && (i == first || items[i-1].group != item.group) {
+		n++
+	}
+	if item.description != "" {
+		n += strings.Count(item.description, "\n") + 1
+	}
+	return n
+}
+
+// visibleEnd returns the index after the last item fitting into height lines of a list starting at index first.
+// The first item is always shown if height is positive.
+// (ai generated comment)
+func visibleEnd(items []*Item, first, height int) int {
+	lines := 0
+	i := first
+	for ; i < len(items) && height > 0; i++ {
+		n := itemLines(items, i, first)
+		if i > first && lines+n > height {
+			break
+		}
+		lines += n
+	}
+	return i
+}
+
+// scrollTo returns the smallest offset not less than offset, or the cursor index if it is above it,
+// that keeps the item at the cursor index visible in height lines.
+// (ai generated comment)
+func scrollTo(items []*Item, offset, index, height int) int {
+	if index < offset {
+		return index
+	}
+	for offset < index && visibleEnd(items, offset, height) <= index {
+		offset++
+	}
+	return offset
+}
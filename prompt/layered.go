@@ -0,0 +1,421 @@
+package prompt
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Layer names a source of default values in a LayeredRegistry.
+// (ai generated comment)
+type Layer string
+
+const (
+	LayerBuiltin Layer = "builtin" // Library defaults
+	LayerSystem  Layer = "system"  // System wide defaults file
+	LayerUser    Layer = "user"    // Per user defaults file
+	LayerEnv     Layer = "env"     // Environment variables
+	LayerCode    Layer = "code"    // Defaults set by the application with SetDefault
+)
+
+// layerOrder lists layers from lowest to highest precedence.
+// (ai generated comment)
+var layerOrder = []Layer{LayerBuiltin, LayerSystem, LayerUser, LayerEnv, LayerCode}
+
+// defaultsLayer holds defaults of one layer both converted and as config values for saving.
+// (ai generated comment)
+type defaultsLayer struct {
+	values *mapDefaultsRegistry      // Converted values by option key and prompt type
+	raw    map[string]map[string]any // Config values by section and option name
+	source string                    // File or environment prefix the layer was loaded from
+}
+
+// LayeredRegistry is a DefaultsRegistry combining defaults from several layers:
+// built-in defaults ← system file ← user file ← environment variables ← code.
+// A value from a higher layer overrides values of lower layers.
+// Files are JSON, YAML or TOML with sections named after prompt types
+// (or "all" for every type) holding option names and values:
+//
+//	[input]
+//	title = "Name:"
+//	string_validator_func = ["non_empty", "max_length:20"]
+//
+// It is safe for concurrent use, e.g. reloading files while prompts run.
+// (ai generated comment)
+type LayeredRegistry struct {
+	mu     sync.RWMutex             // Guards layers
+	layers map[Layer]*defaultsLayer // Layers by name
+}
+
+// NewLayeredRegistry creates a layered registry with built-in defaults and empty other layers.
+// (ai generated comment)
+func NewLayeredRegistry() *LayeredRegistry {
+	r := &LayeredRegistry{layers: map[Layer]*defaultsLayer{}}
+	for _, layer := range layerOrder {
+		r.layers[layer] = newDefaultsLayer()
+	}
+	r.layers[LayerBuiltin].values = defaultRegistry().(*mapDefaultsRegistry)
+	return r
+}
+
+// newDefaultsLayer creates an empty layer.
+// (ai generated comment)
+func newDefaultsLayer() *defaultsLayer {
+	return &defaultsLayer{values: newMapDefaultsRegistry(), raw: map[string]map[string]any{}}
+}
+
+// LoadDefaults creates a layered registry from standard locations:
+// defaults.{json,yaml,yml,toml} in /etc/consolio (system) and in
+// $XDG_CONFIG_HOME/consolio or ~/.config/consolio (user), and CONSOLIO_* environment variables.
+// Missing files are skipped.
+// (ai generated comment)
+func LoadDefaults() (*LayeredRegistry, error) {
+	r := NewLayeredRegistry()
+	dirs := map[Layer]string{
+		LayerSystem: filepath.Join(string(filepath.Separator), "etc", "consolio"),
+		LayerUser:   filepath.Join(configDir(), "consolio"),
+	}
+	for _, layer := range []Layer{LayerSystem, LayerUser} {
+		for _, ext := range []string{".json", ".yaml", ".yml", ".toml"} {
+			path := filepath.Join(dirs[layer], "defaults"+ext)
+			if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			if err := r.LoadFile(layer, path); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	if err := r.LoadEnv("CONSOLIO"); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// configDir returns the XDG config directory of the user.
+// (ai generated comment)
+func configDir() string {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); filepath.IsAbs(dir) {
+		return dir
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(os.TempDir(), ".config")
+	}
+	return filepath.Join(home, ".config")
+}
+
+// layer returns the named layer or an error if the name is unknown.
+// (ai generated comment)
+func (r *LayeredRegistry) layer(layer Layer) (*defaultsLayer, error) {
+	l, ok := r.layers[layer]
+	if !ok {
+		return nil, fmt.Errorf("unknown layer %q", layer)
+	}
+	return l, nil
+}
+
+// GetDefault retrieves the value for the key and prompt type from the highest layer defining it.
+// (ai generated comment)
+func (r *LayeredRegistry) GetDefault(key any, pt promptType) (any, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, layer := range slices.Backward(layerOrder) {
+		if value, ok := r.layers[layer].values.GetDefault(key, pt); ok {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+// SetDefault registers a default value in the code layer.
+// (ai generated comment)
+func (r *LayeredRegistry) SetDefault(key any, pt promptType, value any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.layers[LayerCode].values.SetDefault(key, pt, value)
+}
+
+// Clone creates a copy of the registry with all layers. Values are copied on write.
+// (ai generated comment)
+func (r *LayeredRegistry) Clone() DefaultsRegistry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	clone := &LayeredRegistry{layers: map[Layer]*defaultsLayer{}}
+	for name, l := range r.layers {
+		raw := map[string]map[string]any{}
+		for section, options := range l.raw {
+			raw[section] = maps.Clone(options)
+		}
+		clone.layers[name] = &defaultsLayer{
+			values: l.values.Clone().(*mapDefaultsRegistry),
+			raw:    raw,
+			source: l.source,
+		}
+	}
+	return clone
+}
+
+// Source reports which layer supplies the value for the key and prompt type.
+// (ai generated comment)
+func (r *LayeredRegistry) Source(key any, pt promptType) (Layer, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, layer := range slices.Backward(layerOrder) {
+		if _, ok := r.layers[layer].values.GetDefault(key, pt); ok {
+			return layer, true
+		}
+	}
+	return "", false
+}
+
+// SourceFile returns the file or environment prefix the layer was loaded from.
+// (ai generated comment)
+func (r *LayeredRegistry) SourceFile(layer Layer) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if l, ok := r.layers[layer]; ok {
+		return l.source
+	}
+	return ""
+}
+
+// Set converts a config value and stores it in the layer for the section
+// (prompt type name or "all") and option name.
+// Value may be a string, number, bool, list, mapping, time.Duration or time.Time.
+// (ai generated comment)
+func (r *LayeredRegistry) Set(layer Layer, section, option string, value any) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, err := r.layer(layer)
+	if err != nil {
+		return err
+	}
+	if layer == LayerBuiltin {
+		return fmt.Errorf("built-in layer is read only")
+	}
+	return l.set(section, option, normalizeConfigValue(value))
+}
+
+// set converts and stores a normalized config value.
+// (ai generated comment)
+func (l *defaultsLayer) set(section, option string, raw any) error {
+	types, err := configPromptTypes(section)
+	if err != nil {
+		return err
+	}
+	co, err := lookupConfigOption(option)
+	if err != nil {
+		return err
+	}
+	value, err := co.convert(raw)
+	if err != nil {
+		return fmt.Errorf("%v.%v: %v", section, option, err)
+	}
+	for _, pt := range types {
+		if section == allPromptTypes && l.raw[string(pt)][option] != nil {
+			continue // specific section overrides "all"
+		}
+		l.values.SetDefault(co.key, pt, value)
+	}
+	if l.raw[section] == nil {
+		l.raw[section] = map[string]any{}
+	}
+	l.raw[section][option] = raw
+	return nil
+}
+
+// loadLayer creates a layer from a parsed defaults document.
+// Values of the "all" section are applied before prompt type sections.
+// (ai generated comment)
+func loadLayer(doc map[string]any, source string) (*defaultsLayer, error) {
+	l := newDefaultsLayer()
+	l.source = source
+	sections := sortedKeys(doc)
+	slices.SortStableFunc(sections, func(a, b string) int {
+		switch {
+		case a == allPromptTypes && b != allPromptTypes:
+			return -1
+		case b == allPromptTypes && a != allPromptTypes:
+			return 1
+		}
+		return 0
+	})
+	for _, section := range sections {
+		options, ok := doc[section].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("section %v must be a mapping", section)
+		}
+		for _, option := range sortedKeys(options) {
+			if err := l.set(section, option, options[option]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return l, nil
+}
+
+// LoadFile loads the layer from a JSON, YAML or TOML file, replacing its previous contents.
+// The layer is left unchanged if the file can not be loaded.
+// (ai generated comment)
+func (r *LayeredRegistry) LoadFile(layer Layer, path string) error {
+	r.mu.RLock()
+	_, err := r.layer(layer)
+	r.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+	if layer == LayerBuiltin {
+		return fmt.Errorf("built-in layer is read only")
+	}
+	format, err := formatOf(path)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read defaults: %v", err)
+	}
+	doc, err := decodeConfig(format, data)
+	if err != nil {
+		return fmt.Errorf("failed to parse defaults %v: %v", path, err)
+	}
+	l, err := loadLayer(doc, path)
+	if err != nil {
+		return fmt.Errorf("bad defaults %v: %v", path, err)
+	}
+	r.mu.Lock()
+	r.layers[layer] = l
+	r.mu.Unlock()
+	return nil
+}
+
+// SaveFile writes values of the layer to a JSON, YAML or TOML file.
+// (ai generated comment)
+func (r *LayeredRegistry) SaveFile(layer Layer, path string) error {
+	format, err := formatOf(path)
+	if err != nil {
+		return err
+	}
+	r.mu.RLock()
+	l, err := r.layer(layer)
+	doc := map[string]any{}
+	if err == nil {
+		for section, options := range l.raw {
+			doc[section] = maps.Clone(options)
+		}
+	}
+	r.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+	data, err := encodeConfig(format, doc)
+	if err != nil {
+		return fmt.Errorf("failed to encode defaults: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create defaults directory: %v", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write defaults: %v", err)
+	}
+	return nil
+}
+
+// LoadEnv loads the environment layer from variables named PREFIX_SECTION_OPTION,
+// e.g. CONSOLIO_INPUT_TITLE or CONSOLIO_ALL_WIDTH, replacing its previous contents.
+// Lists are comma separated and mappings are written as k=v,k2=v2. Commas inside brackets,
+// braces, parentheses or double quotes do not separate items, e.g. "non_empty,regexp:^a{1,3}$".
+// (ai generated comment)
+func (r *LayeredRegistry) LoadEnv(prefix string) error {
+	doc := map[string]any{}
+	prefix = strings.ToUpper(prefix) + "_"
+	sections := []string{allPromptTypes}
+	for _, pt := range promptTypes {
+		sections = append(sections, string(pt))
+	}
+	for _, env := range os.Environ() {
+		name, value, _ := strings.Cut(env, "=")
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		rest := strings.ToLower(strings.TrimPrefix(name, prefix))
+		for _, section := range sections {
+			option, ok := strings.CutPrefix(rest, section+"_")
+			if !ok {
+				continue
+			}
+			if _, err := lookupConfigOption(option); err != nil {
+				continue
+			}
+			if doc[section] == nil {
+				doc[section] = map[string]any{}
+			}
+			doc[section].(map[string]any)[option] = value
+			break
+		}
+	}
+	l, err := loadLayer(doc, strings.TrimSuffix(prefix, "_"))
+	if err != nil {
+		return fmt.Errorf("bad environment defaults: %v", err)
+	}
+	r.mu.Lock()
+	r.layers[LayerEnv] = l
+	r.mu.Unlock()
+	return nil
+}
+
+// normalizeConfigValue converts Go values to the config value types used by decoders.
+// (ai generated comment)
+func normalizeConfigValue(value any) any {
+	switch v := value.(type) {
+	case int:
+		return int64(v)
+	case time.Duration:
+		return v.String()
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case *time.Location:
+		return v.String()
+	case []string:
+		list := []any{}
+		for _, item := range v {
+			list = append(list, item)
+		}
+		return list
+	case []int:
+		list := []any{}
+		for _, item := range v {
+			list = append(list, int64(item))
+		}
+		return list
+	case []any:
+		list := []any{}
+		for _, item := range v {
+			list = append(list, normalizeConfigValue(item))
+		}
+		return list
+	case map[string]string:
+		mapping := map[string]any{}
+		for key, item := range v {
+			mapping[key] = item
+		}
+		return mapping
+	case map[int]string:
+		mapping := map[string]any{}
+		for key, item := range v {
+			mapping[strconv.Itoa(key)] = item
+		}
+		return mapping
+	}
+	return value
+}
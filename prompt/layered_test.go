@@ -0,0 +1,186 @@
+package prompt
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// writeFile writes a test file into the directory and returns its path
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// TestLayeredRegistryPrecedence tests that higher layers override lower ones and report their source
+func TestLayeredRegistryPrecedence(t *testing.T) {
+	dir := t.TempDir()
+	r := NewLayeredRegistry()
+	if err := r.LoadFile(LayerSystem, writeFile(t, dir, "system.toml", "[all]\nwidth = 60\n[input]\ntitle = \"system\"\n")); err != nil {
+		t.Fatalf("LoadFile(system) error = %v", err)
+	}
+	if err := r.LoadFile(LayerUser, writeFile(t, dir, "user.yaml", "input:\n  title: user\n")); err != nil {
+		t.Fatalf("LoadFile(user) error = %v", err)
+	}
+	t.Setenv("TESTCONSOLIO_SELECT_MULTI_HEIGHT", "7")
+	t.Setenv("TESTCONSOLIO_INPUT_UNKNOWN", "ignored")
+	if err := r.LoadEnv("TESTCONSOLIO"); err != nil {
+		t.Fatalf("LoadEnv() error = %v", err)
+	}
+	r.SetDefault(KeyPlaceholder, ptInput, "code")
+
+	tests := []struct {
+		key   any
+		pt    promptType
+		want  any
+		layer Layer
+	}{
+		{KeyTitle, ptInput, "user", LayerUser},
+		{KeyTitle, ptSelect, "select one item:", LayerBuiltin},
+		{KeyWidth, ptConfirm, 60, LayerSystem},
+		{KeyHeight, ptSelectMulti, 7, LayerEnv},
+		{KeyPlaceholder, ptInput, "code", LayerCode},
+	}
+	for _, tt := range tests {
+		got, _ := r.GetDefault(tt.key, tt.pt)
+		layer, _ := r.Source(tt.key, tt.pt)
+		if got != tt.want || layer != tt.layer {
+			t.Errorf("%v/%v = %v from %v, want %v from %v", tt.pt, tt.key, got, layer, tt.want, tt.layer)
+		}
+	}
+	if got := r.SourceFile(LayerUser); got != filepath.Join(dir, "user.yaml") {
+		t.Errorf("SourceFile(user) = %v", got)
+	}
+}
+
+// TestLayeredRegistrySpecificOverridesAll tests that prompt type sections override the all section
+func TestLayeredRegistrySpecificOverridesAll(t *testing.T) {
+	r := NewLayeredRegistry()
+	if err := r.Set(LayerUser, "input", "width", 30); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Set(LayerUser, "all", "width", 90); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := r.GetDefault(KeyWidth, ptInput); got != 30 {
+		t.Errorf("input width = %v, want 30", got)
+	}
+	if got, _ := r.GetDefault(KeyWidth, ptSearch); got != 90 {
+		t.Errorf("search width = %v, want 90", got)
+	}
+}
+
+// TestLayeredRegistryErrors tests rejection of bad files and values
+func TestLayeredRegistryErrors(t *testing.T) {
+	dir := t.TempDir()
+	r := NewLayeredRegistry()
+	r.Set(LayerUser, "input", "title", "kept")
+	for name, content := range map[string]string{
+		"type.json":   `{"dialog": {"title": "x"}}`,
+		"option.json": `{"input": {"colour": "x"}}`,
+		"value.json":  `{"input": {"width": "wide"}}`,
+	} {
+		if err := r.LoadFile(LayerUser, writeFile(t, dir, name, content)); err == nil {
+			t.Errorf("LoadFile(%v) should fail", name)
+		}
+	}
+	if got, _ := r.GetDefault(KeyTitle, ptInput); got != "kept" {
+		t.Errorf("failed load should keep layer, title = %v", got)
+	}
+	if err := r.Set(LayerBuiltin, "input", "title", "x"); err == nil {
+		t.Error("built-in layer should be read only")
+	}
+	if err := r.LoadFile(LayerUser, filepath.Join(dir, "defaults.ini")); err == nil {
+		t.Error("LoadFile() should reject unknown format")
+	}
+}
+
+// TestLayeredRegistrySaveFile tests that saved layers load back to the same values
+func TestLayeredRegistrySaveFile(t *testing.T) {
+	dir := t.TempDir()
+	r := NewLayeredRegistry()
+	r.Set(LayerUser, "input", "title", "Name:")
+	r.Set(LayerUser, "input", "string_validator_func", []string{"non_empty", "max_length:5"})
+	r.Set(LayerUser, "input", "validation_debounce", 500*time.Millisecond)
+	r.Set(LayerUser, "slider", "tick_labels", map[int]string{0: "off"})
+	r.Set(LayerUser, "all", "theme", "charm")
+
+	for _, name := range []string{"defaults.json", "defaults.yaml", "defaults.toml"} {
+		path := filepath.Join(dir, name)
+		if err := r.SaveFile(LayerUser, path); err != nil {
+			t.Fatalf("SaveFile(%v) error = %v", name, err)
+		}
+		loaded := NewLayeredRegistry()
+		if err := loaded.LoadFile(LayerUser, path); err != nil {
+			t.Fatalf("LoadFile(%v) error = %v", name, err)
+		}
+		if got, _ := loaded.GetDefault(KeyValidationDebounce, ptInput); got != 500*time.Millisecond {
+			t.Errorf("%v: debounce = %v", name, got)
+		}
+		if got, _ := loaded.GetDefault(KeyTickLabels, ptSlider); got.(map[int]string)[0] != "off" {
+			t.Errorf("%v: tick labels = %v", name, got)
+		}
+		validator, _ := loaded.GetDefault(KeyStringValidatorFunc, ptInput)
+		if validator.(StringValidatorFunc)("toolong") == nil {
+			t.Errorf("%v: validator should be restored", name)
+		}
+	}
+}
+
+// TestWithDefaults tests that prompts take values from the given registry
+func TestWithDefaults(t *testing.T) {
+	r := NewLayeredRegistry()
+	r.Set(LayerUser, "input", "title", "from registry")
+	pb := newTestBuilder(t, ptInput, WithDefaults(r))
+	if got := pb.getTitle(); got != "from registry" {
+		t.Errorf("getTitle() = %v, want from registry", got)
+	}
+	if got := newTestBuilder(t, ptInput, WithDefaults(r), WithTitle("explicit")).getTitle(); got != "explicit" {
+		t.Errorf("getTitle() = %v, want explicit option to win", got)
+	}
+}
+
+// TestLoadEnvLists tests that environment lists split only at top-level commas
+func TestLoadEnvLists(t *testing.T) {
+	t.Setenv("TESTENV_INPUT_STRING_VALIDATOR_FUNC", "non_empty, regexp:^a{1,3}$")
+	t.Setenv("TESTENV_INPUT_DEFAULT_VALUES", `a, "b,c", (d,e)`)
+	r := NewLayeredRegistry()
+	if err := r.LoadEnv("testenv"); err != nil {
+		t.Fatalf("LoadEnv() error = %v", err)
+	}
+	raw, _ := r.GetDefault(KeyStringValidatorFunc, ptInput)
+	validator := raw.(StringValidatorFunc)
+	for value, valid := range map[string]bool{"aa": true, "": false, "aaaa": false} {
+		if (validator(value) == nil) != valid {
+			t.Errorf("validator(%q) = %v, want valid %v", value, validator(value), valid)
+		}
+	}
+	if got, _ := r.GetDefault(KeyDefaultValues, ptInput); !reflect.DeepEqual(got, []string{"a", "b,c", "(d,e)"}) {
+		t.Errorf("default values = %q, want a, b,c and (d,e)", got)
+	}
+}
+
+// TestLoadDefaults tests loading of the user file from the XDG config directory and environment
+func TestLoadDefaults(t *testing.T) {
+	dir := t.TempDir()
+	os.MkdirAll(filepath.Join(dir, "consolio"), 0o755)
+	writeFile(t, filepath.Join(dir, "consolio"), "defaults.yml", "confirm:\n  affirmative: Sure\n")
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("CONSOLIO_CONFIRM_NEGATIVE", "Nope")
+	r, err := LoadDefaults()
+	if err != nil {
+		t.Fatalf("LoadDefaults() error = %v", err)
+	}
+	if got, _ := r.GetDefault(KeyAffirmative, ptConfirm); got != "Sure" {
+		t.Errorf("affirmative = %v, want Sure", got)
+	}
+	if got, _ := r.GetDefault(KeyNegative, ptConfirm); got != "Nope" {
+		t.Errorf("negative = %v, want Nope", got)
+	}
+}
@@ -0,0 +1,699 @@
+package prompt
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/x/term"
+)
+
+// hasTerminal reports whether the prompt reads keys from a terminal.
+// (ai generated comment)
+func (pb *promptBuilder) hasTerminal() bool {
+	in := pb.getInput()
+	if in == nil {
+		in = os.Stdin
+	}
+	return term.IsTerminal(in.Fd())
+}
+
+// lineOriented reports whether prompts ask line by line instead of drawing:
+// in accessible mode and when input is not a terminal (pipes, CI, expect scripts).
+// (ai generated comment)
+func (pb *promptBuilder) lineOriented() bool {
+	return pb.getAccessible() || !pb.hasTerminal()
+}
+
+// lineSession asks questions line by line on the terminal of a prompt builder.
+// Nothing is redrawn: every question, list and error is printed once as plain text.
+// (ai generated comment)
+type lineSession struct {
+	in       io.Reader          // Reader of answers
+	out      io.Writer          // Writer of questions
+	warnings warningState       // Validation warning of the last answer
+	hooks    hooks              // Lifecycle callbacks
+	msgs     localizer          // Translator of built-in strings
+	veto     func(string) error // Asks OnSubmit callback whether the accepted answer completes the prompt
+}
+
+// newLineSession creates a line session reading from the configured input (stdin by default)
+// and writing to the configured output (stdout by default).
+// (ai generated comment)
+func newLineSession(pb *promptBuilder) *lineSession {
+	var in io.Reader = os.Stdin
+	var out io.Writer = os.Stdout
+	if f := pb.getInput(); f != nil {
+		in = f
+	}
+	if f := pb.getOutput(); f != nil {
+		out = f
+	}
+	return &lineSession{
+		in:       in,
+		out:      out,
+		warnings: warningState{confirm: pb.getConfirmWarnings()},
+		hooks:    pb.hooks(),
+		msgs:     pb.localizer(),
+	}
+}
+
+// printf prints formatted text.
+// (ai generated comment)
+func (ls *lineSession) printf(format string, args ...any) {
+	_, _ = fmt.Fprintf(ls.out, format, args...)
+}
+
+// header prints the title and the description of the prompt.
+// (ai generated comment)
+func (ls *lineSession) header(title, description string) {
+	if title != "" {
+		ls.printf("%v\n", title)
+	}
+	if description != "" {
+		ls.printf("%v\n", description)
+	}
+}
+
+// readLine prints the question and reads one answer without surrounding spaces.
+// End of input cancels the prompt.
+// (ai generated comment)
+func (ls *lineSession) readLine(question string) (string, error) {
+	ls.printf("%v", question)
+	line, err := readLine(ls.in)
+	if err != nil {
+		ls.printf("\n")
+		if !errors.Is(err, io.EOF) {
+			return "", fmt.Errorf("failed to read answer: %v", err)
+		}
+		err := fmt.Errorf("input %w: end of input", ErrCanceled)
+		ls.hooks.cancel(err)
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// readLine reads one line byte by byte without reading ahead, so the rest of the input
+// stays available to the next prompt or to the program. The last line may lack a line break.
+// (ai generated comment)
+func readLine(in io.Reader) (string, error) {
+	line := []byte{}
+	b := make([]byte, 1)
+	for {
+		n, err := in.Read(b)
+		if n > 0 {
+			if b[0] == '\n' {
+				return string(line), nil
+			}
+			line = append(line, b[0])
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) && len(line) > 0 {
+				return string(line), nil
+			}
+			return "", err
+		}
+	}
+}
+
+// ask repeats the question until the answer passes the check.
+// Blocking errors are printed and the question is asked again.
+// Warnings are printed; with warning confirmation the same answer must be given twice.
+// (ai generated comment)
+func (ls *lineSession) ask(question string, check func(string) error) (string, error) {
+	last := ""
+	for {
+		answer, err := ls.readLine(question)
+		if err != nil {
+			return "", err
+		}
+		if answer != last {
+			ls.warnings.show(nil)
+		}
+		last = answer
+		proceed, err := ls.warnings.check(check(answer))
+		if err != nil {
+			ls.hooks.invalid(answer, err)
+			ls.printf("%v\n", ls.msgs.t("Error: %v", err))
+			continue
+		}
+		if ls.warnings.message != "" {
+			ls.printf("%v\n", ls.msgs.t("Warning: %v", ls.warnings.message))
+		}
+		if !proceed {
+			ls.printf("%v\n", ls.msgs.t("Enter the same answer again to proceed anyway."))
+			continue
+		}
+		if ls.veto != nil {
+			if err := ls.veto(answer); err != nil {
+				ls.printf("%v\n", ls.msgs.t("Error: %v", err))
+				continue
+			}
+		}
+		return answer, nil
+	}
+}
+
+// listItems prints items as a numbered list with group headers, hints,
+// descriptions and disabled reasons.
+// (ai generated comment)
+func (ls *lineSession) listItems(items []*Item) {
+	for i, item := range items {
+		if item.group != "" && (i == 0 || items[i-1].group != item.group) {
+			ls.printf("%v:\n", item.group)
+		}
+		line := fmt.Sprintf("%d. %v", i+1, item.key)
+		if item.hint != "" {
+			line += " [" + item.hint + "]"
+		}
+		if item.description != "" {
+			line += " - " + item.description
+		}
+		if item.disabled {
+			line += " (" + cmp.Or(item.disabledReason, ls.msgs.t("disabled")) + ")"
+		}
+		ls.printf("%v\n", line)
+	}
+}
+
+// parseChoice converts an answer to the item at 1-based position in the list.
+// Disabled items can not be chosen. Errors are translated with msgs.
+// (ai generated comment)
+func parseChoice(msgs localizer, answer string, items []*Item) (*Item, error) {
+	n, err := strconv.Atoi(answer)
+	if err != nil || n < 1 || n > len(items) {
+		return nil, errors.New(msgs.t("enter a number between 1 and %v", len(items)))
+	}
+	item := items[n-1]
+	if item.disabled {
+		return nil, errors.New(msgs.t("%v can not be selected: %v", item.key, cmp.Or(item.disabledReason, msgs.t("disabled"))))
+	}
+	return item, nil
+}
+
+// syncCheck runs an asynchronous validator to completion.
+// Returns nil validator result if no asynchronous validator is set.
+// (ai generated comment)
+func syncCheck[T any](validate func(context.Context, T) error, value T) error {
+	if validate == nil {
+		return nil
+	}
+	return validate(context.Background(), value)
+}
+
+// lineInput asks for a text value. Empty answer keeps the default value.
+// Suggestions are not offered; asynchronous validation runs on submit.
+// (ai generated comment)
+func lineInput(pb *promptBuilder) (string, error) {
+	ls := newLineSession(pb)
+	ls.header(pb.getTitle(), pb.getDescription())
+	defaultValue := pb.getDefaultValue()
+	question := pb.getPrompt()
+	if defaultValue != "" {
+		question += ls.msgs.t("(default %v) ", defaultValue)
+	}
+	validator, async := pb.getStringValidator(), pb.getAsyncStringValidator()
+	ls.veto = func(answer string) error {
+		if answer == "" {
+			answer = defaultValue
+		}
+		return ls.hooks.submit(answer)
+	}
+	answer, err := ls.ask(question, func(answer string) error {
+		if answer == "" {
+			answer = defaultValue
+		}
+		err := validator(answer)
+		if isBlocking(err) {
+			return err
+		}
+		return cmpErr(syncCheck(async, answer), err)
+	})
+	if err != nil {
+		return "", err
+	}
+	if answer == "" {
+		answer = defaultValue
+	}
+	return answer, nil
+}
+
+// cmpErr returns the first blocking error, otherwise the first warning.
+// (ai generated comment)
+func cmpErr(errs ...error) error {
+	for _, err := range errs {
+		if isBlocking(err) {
+			return err
+		}
+	}
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lineSelect prints items as a numbered list and asks for one number,
+// or for numbers and ranges in multiple selection.
+// Empty answer keeps preselected items.
+// (ai generated comment)
+func lineSelect(pb *promptBuilder) ([]*Item, error) {
+	items := pb.getItems()
+	if err := checkItemPool(items, pb.promptType == ptSelectMulti); err != nil {
+		return nil, err
+	}
+	preselected := matchItems(items, pb.getSelected())
+	ls := newLineSession(pb)
+	ls.header(pb.getTitle(), pb.getDescription())
+	ls.listItems(items)
+	if pb.promptType == ptSelectMulti {
+		validator := pb.getItemListValidator()
+		var selected []*Item
+		ls.veto = func(string) error { return ls.hooks.submit(selected) }
+		_, err := ls.ask(ls.msgs.t("Enter numbers or ranges like 1-3,7: "), func(answer string) error {
+			selected = preselected
+			if answer != "" {
+				choices, err := parseChoices(ls.msgs, answer, items)
+				if err != nil {
+					return err
+				}
+				selected = choices
+			}
+			return validator(selected)
+		})
+		return selected, err
+	}
+	validator, async := pb.getItemValidator(), pb.getAsyncItemValidator()
+	var selected *Item
+	ls.veto = func(string) error { return ls.hooks.submit(selected) }
+	_, err := ls.ask(ls.msgs.t("Enter a number between 1 and %v: ", len(items)), func(answer string) error {
+		if answer == "" && len(preselected) > 0 {
+			selected = preselected[0]
+		} else {
+			choice, err := parseChoice(ls.msgs, answer, items)
+			if err != nil {
+				return err
+			}
+			selected = choice
+		}
+		err := validator(selected)
+		if isBlocking(err) {
+			return err
+		}
+		return cmpErr(syncCheck(async, selected), err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return []*Item{selected}, nil
+}
+
+// parseChoices converts an answer with numbers and ranges like "1-3,7" separated
+// by commas or spaces to items. Disabled items inside ranges are skipped. Errors are translated with msgs.
+// (ai generated comment)
+func parseChoices(msgs localizer, answer string, items []*Item) ([]*Item, error) {
+	choices := []*Item{}
+	add := func(item *Item) {
+		if !slices.Contains(choices, item) {
+			choices = append(choices, item)
+		}
+	}
+	for _, field := range strings.FieldsFunc(answer, func(r rune) bool { return r == ',' || r == ' ' }) {
+		first, last, isRange := strings.Cut(field, "-")
+		if !isRange {
+			item, err := parseChoice(msgs, field, items)
+			if err != nil {
+				return nil, err
+			}
+			add(item)
+			continue
+		}
+		low, errLow := strconv.Atoi(first)
+		high, errHigh := strconv.Atoi(last)
+		if errLow != nil || errHigh != nil || low < 1 || high > len(items) || low > high {
+			return nil, errors.New(msgs.t("bad range %v: expected numbers between 1 and %v like 1-3", field, len(items)))
+		}
+		for _, item := range items[low-1 : high] {
+			if !item.disabled {
+				add(item)
+			}
+		}
+	}
+	return choices, nil
+}
+
+// lineSearch asks for a filter, prints matching items as a numbered list
+// and asks for a number. Empty answer goes back to the filter question.
+// (ai generated comment)
+func lineSearch(pb *promptBuilder) (*Item, error) {
+	sm, err := newSearch(pb)
+	if err != nil {
+		return nil, err
+	}
+	ls := newLineSession(pb)
+	ls.header(sm.title, sm.description)
+	async := pb.getAsyncItemValidator()
+	for {
+		filter, err := ls.readLine(ls.msgs.t("Filter (empty for all items): "))
+		if err != nil {
+			return nil, err
+		}
+		sm.filter = filter
+		sm.updateFilter()
+		ls.hooks.change(filter)
+		if len(sm.filteredList) == 0 {
+			ls.printf("%v\n", ls.msgs.t("No items match %q.", filter))
+			continue
+		}
+		ls.printf("%v\n", ls.msgs.plural("%v of %v item:|%v of %v items:", len(sm.fullList), len(sm.filteredList), len(sm.fullList)))
+		ls.listItems(sm.filteredList)
+		var selected *Item
+		ls.veto = func(answer string) error {
+			if answer == "" {
+				return nil
+			}
+			return ls.hooks.submit(selected)
+		}
+		question := ls.msgs.t("Enter a number between 1 and %v, or nothing to change the filter: ", len(sm.filteredList))
+		answer, err := ls.ask(question, func(answer string) error {
+			if answer == "" {
+				return nil
+			}
+			choice, err := parseChoice(ls.msgs, answer, sm.filteredList)
+			if err != nil {
+				return err
+			}
+			selected = choice
+			err = sm.validator(choice)
+			if isBlocking(err) {
+				return err
+			}
+			return cmpErr(syncCheck(async, choice), err)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if answer != "" {
+			return selected, nil
+		}
+	}
+}
+
+// lineInputList asks for values one per line until an empty line.
+// A line may hold several values separated by commas.
+// (ai generated comment)
+func lineInputList(pb *promptBuilder) ([]string, error) {
+	lm, err := newListModel(pb)
+	if err != nil {
+		return nil, err
+	}
+	ls := newLineSession(pb)
+	ls.header(lm.title, lm.description)
+	if len(lm.values) > 0 {
+		ls.printf("%v\n", ls.msgs.t("Values: %v", strings.Join(lm.values, ", ")))
+	}
+	ls.printf("%v\n", ls.msgs.t("Enter values one per line, an empty line finishes the list."))
+	ls.veto = func(answer string) error {
+		if answer != "" {
+			return nil
+		}
+		return ls.hooks.submit(lm.values)
+	}
+	for {
+		answer, err := ls.ask(pb.getPrompt(), func(answer string) error {
+			if answer == "" {
+				return lm.listValidator(lm.values)
+			}
+			var warning error
+			for _, value := range splitListInput(answer) {
+				err := lm.checkValue(value)
+				if isBlocking(err) {
+					return fmt.Errorf("%v: %v", value, err)
+				}
+				if err != nil {
+					warning = Warn("%v: %v", value, err)
+				}
+			}
+			return warning
+		})
+		if err != nil {
+			return nil, err
+		}
+		if answer == "" {
+			return lm.values, nil
+		}
+		lm.values = append(lm.values, splitListInput(answer)...)
+		ls.hooks.change(lm.values)
+	}
+}
+
+// lineEditMap prints the pairs and reads edits one per line:
+// "key=value" sets a pair, "-key" deletes it and an empty line finishes editing.
+// (ai generated comment)
+func lineEditMap(pb *promptBuilder) (map[string]string, MapDiff, error) {
+	mm, err := newMapModel(pb)
+	if err != nil {
+		return nil, MapDiff{}, err
+	}
+	result := maps.Clone(mm.original)
+	ls := newLineSession(pb)
+	ls.header(mm.title, mm.description)
+	for _, k := range slices.Sorted(maps.Keys(result)) {
+		ls.printf("%v=%v\n", k, result[k])
+	}
+	ls.printf("%v\n", ls.msgs.t("Enter key=value to set a pair, -key to delete it, an empty line finishes editing."))
+	ls.veto = func(answer string) error {
+		if answer != "" {
+			return nil
+		}
+		return ls.hooks.submit(MapResult{Pairs: result, Diff: DiffMaps(mm.original, result)})
+	}
+	for {
+		answer, err := ls.ask("> ", func(answer string) error {
+			if answer == "" {
+				return nil
+			}
+			if k, ok := strings.CutPrefix(answer, "-"); ok {
+				if _, exists := result[strings.TrimSpace(k)]; !exists {
+					return errors.New(ls.msgs.t("key %v does not exist", strings.TrimSpace(k)))
+				}
+				return nil
+			}
+			k, v, ok := strings.Cut(answer, "=")
+			k = strings.TrimSpace(k)
+			if !ok || k == "" {
+				return errors.New(ls.msgs.t("expected key=value or -key"))
+			}
+			keyErr, valueErr := mm.keyValidator(k), mm.valueValidator(v)
+			switch {
+			case isBlocking(keyErr):
+				return fmt.Errorf("%v: %v", ls.msgs.t("key"), keyErr)
+			case isBlocking(valueErr):
+				return fmt.Errorf("%v: %v", ls.msgs.t("value"), valueErr)
+			case keyErr != nil:
+				return Warn("%v: %v", ls.msgs.t("key"), keyErr)
+			case valueErr != nil:
+				return Warn("%v: %v", ls.msgs.t("value"), valueErr)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, MapDiff{}, err
+		}
+		if answer == "" {
+			return result, DiffMaps(mm.original, result), nil
+		}
+		if k, ok := strings.CutPrefix(answer, "-"); ok {
+			delete(result, strings.TrimSpace(k))
+		} else {
+			k, v, _ := strings.Cut(answer, "=")
+			result[strings.TrimSpace(k)] = v
+		}
+		ls.hooks.change(result)
+	}
+}
+
+// lineSlider asks for a value, or low and high values in range mode, within slider bounds.
+// Empty answer keeps the initial values.
+// (ai generated comment)
+func lineSlider(pb *promptBuilder) ([2]int, error) {
+	sm, err := newSliderModel(pb)
+	if err != nil {
+		return [2]int{}, err
+	}
+	ls := newLineSession(pb)
+	ls.header(sm.title, sm.description)
+	question := ls.msgs.t("Enter a value between %v and %v (default %v): ", sm.min, sm.max, sm.formatValue(sm.values[0]))
+	if sm.rangeMode {
+		question = ls.msgs.t("Enter low and high values between %v and %v (default %v %v): ",
+			sm.min, sm.max, sm.formatValue(sm.values[0]), sm.formatValue(sm.values[1]))
+	}
+	values := sm.values
+	ls.veto = func(string) error {
+		if sm.rangeMode {
+			return ls.hooks.submit(values)
+		}
+		return ls.hooks.submit(values[0])
+	}
+	_, err = ls.ask(question, func(answer string) error {
+		values = sm.values
+		if answer == "" {
+			return nil
+		}
+		want := 1
+		if sm.rangeMode {
+			want = 2
+		}
+		fields := strings.FieldsFunc(answer, func(r rune) bool { return r == ',' || r == ' ' })
+		if len(fields) != want {
+			return errors.New(ls.msgs.plural("expected %v value|expected %v values", want, want))
+		}
+		for i, field := range fields {
+			v, err := strconv.Atoi(field)
+			if err != nil {
+				return errors.New(ls.msgs.t("%v is not a number", field))
+			}
+			if v < sm.min || v > sm.max {
+				return errors.New(ls.msgs.t("%v is out of range %v..%v", v, sm.min, sm.max))
+			}
+			if v != sm.max && (v-sm.min)%sm.step != 0 {
+				return errors.New(ls.msgs.t("%v is not a multiple of step %v from %v", v, sm.step, sm.min))
+			}
+			values[i] = v
+		}
+		if sm.rangeMode && values[0] > values[1] {
+			return errors.New(ls.msgs.t("low value is greater than high value"))
+		}
+		return nil
+	})
+	return values, err
+}
+
+// lineTime asks for a date, time or duration expression.
+// Empty answer keeps the initial value.
+// (ai generated comment)
+func lineTime(pb *promptBuilder) (time.Time, time.Duration, error) {
+	tm, err := newTimeModel(pb)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	ls := newLineSession(pb)
+	ls.header(tm.title, tm.description)
+	initial := *tm
+	ls.veto = func(string) error { return ls.hooks.submit(tm.resultValue()) }
+	_, err = ls.ask(ls.msgs.t("Enter a value (default %v): ", tm.formatValue()), func(answer string) error {
+		*tm = initial
+		tm.expr = answer
+		if answer != "" {
+			if err := tm.applyExpression(); err != nil {
+				return err
+			}
+		}
+		if err := tm.checkBounds(); err != nil {
+			return err
+		}
+		return tm.validator(tm.formatValue())
+	})
+	return tm.value, tm.duration, err
+}
+
+// lineConfirm asks a yes/no question. Answers are matched against affirmative and negative
+// labels, their first letters, English yes/no and yes/no words of the prompt language.
+// Empty answer keeps the default.
+// (ai generated comment)
+func lineConfirm(pb *promptBuilder) (bool, error) {
+	affirmative, negative := pb.getAffirmative(), pb.getNegative()
+	yes, no := confirmWords(affirmative, negative, pb.localizer().base())
+	val := pb.getDefaultConfirm()
+	defaultLabel := negative
+	if val {
+		defaultLabel = affirmative
+	}
+	ls := newLineSession(pb)
+	ls.header(pb.getTitle(), pb.getDescription())
+	question := fmt.Sprintf("%v/%v %v", affirmative, negative, ls.msgs.t("(default %v): ", defaultLabel))
+	ls.veto = func(string) error { return ls.hooks.submit(val) }
+	_, err := ls.ask(question, func(answer string) error {
+		answer = strings.ToLower(answer)
+		switch {
+		case answer == "":
+		case slices.Contains(yes, answer):
+			val = true
+		case slices.Contains(no, answer):
+			val = false
+		default:
+			return errors.New(ls.msgs.t("answer %v or %v", affirmative, negative))
+		}
+		return nil
+	})
+	return val, err
+}
+
+// confirmLocaleWords lists affirmative and negative answers by language.
+// (ai generated comment)
+var confirmLocaleWords = map[string][2][]string{
+	"de": {{"ja", "j"}, {"nein", "n"}},
+	"es": {{"sí", "si", "s"}, {"no", "n"}},
+	"fr": {{"oui", "o"}, {"non", "n"}},
+	"it": {{"sì", "si", "s"}, {"no", "n"}},
+	"nl": {{"ja", "j"}, {"nee", "n"}},
+	"pl": {{"tak", "t"}, {"nie", "n"}},
+	"pt": {{"sim", "s"}, {"não", "nao", "n"}},
+	"ru": {{"да", "д"}, {"нет", "н"}},
+	"sv": {{"ja", "j"}, {"nej", "n"}},
+	"uk": {{"так", "т"}, {"ні", "н"}},
+}
+
+// confirmWords returns lower case affirmative and negative answers for the labels and language.
+// Words matching both sides are dropped.
+// (ai generated comment)
+func confirmWords(affirmative, negative, language string) ([]string, []string) {
+	words := func(label string, common []string, local []string) []string {
+		list := append([]string{}, common...)
+		if label = strings.ToLower(strings.TrimSpace(label)); label != "" {
+			list = append(list, label, string([]rune(label)[:1]))
+		}
+		return append(list, local...)
+	}
+	local := confirmLocaleWords[language]
+	yes := words(affirmative, []string{"y", "yes"}, local[0])
+	no := words(negative, []string{"n", "no"}, local[1])
+	both := []string{}
+	for _, w := range yes {
+		if slices.Contains(no, w) {
+			both = append(both, w)
+		}
+	}
+	ambiguous := func(w string) bool { return slices.Contains(both, w) }
+	return slices.DeleteFunc(yes, ambiguous), slices.DeleteFunc(no, ambiguous)
+}
+
+// localeLanguage returns the language tag of the current locale taken from
+// LC_ALL, LC_MESSAGES or LANG, e.g. "pt_br" for pt_BR.UTF-8. The localizer looks up
+// the tag with region first and then its base language. Returns empty string for C and POSIX locales.
+// (ai generated comment)
+func localeLanguage() string {
+	for _, env := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		value := os.Getenv(env)
+		if value == "" {
+			continue
+		}
+		language, _, _ := strings.Cut(value, ".")
+		language, _, _ = strings.Cut(language, "@")
+		language = normalizeLanguage(language)
+		if language == "c" || language == "posix" {
+			return ""
+		}
+		return language
+	}
+	return ""
+}
@@ -0,0 +1,203 @@
+package prompt
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// lineTerminal returns options reading answers from a file and writing to another one,
+// and a function returning everything written so far
+func lineTerminal(t *testing.T, answers string) ([]PromptOption, func() string) {
+	t.Helper()
+	dir := t.TempDir()
+	inPath := filepath.Join(dir, "in")
+	if err := os.WriteFile(inPath, []byte(answers), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	in, err := os.Open(inPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := os.Create(filepath.Join(dir, "out"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		in.Close()
+		out.Close()
+	})
+	output := func() string {
+		data, err := os.ReadFile(out.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	return []PromptOption{WithInput(in), WithOutput(out)}, output
+}
+
+// TestParseChoices tests numbers and ranges of multiple selection answers
+func TestParseChoices(t *testing.T) {
+	items := testSearchItems()
+	tests := []struct {
+		answer string
+		want   []string
+		err    bool
+	}{
+		{answer: "1", want: []string{"apple"}},
+		{answer: "1-3, 4", want: []string{"apple", "carrot", "apricot"}},
+		{answer: "4 1-1,4", want: []string{"apricot", "apple"}},
+		{answer: "", want: []string{}},
+		{answer: "2", err: true},
+		{answer: "3-1", err: true},
+		{answer: "1-9", err: true},
+		{answer: "x", err: true},
+	}
+	for _, tt := range tests {
+		choices, err := parseChoices(localizer{}, tt.answer, items)
+		if (err != nil) != tt.err {
+			t.Errorf("parseChoices(%q) error = %v, want error %v", tt.answer, err, tt.err)
+			continue
+		}
+		keys := []string{}
+		for _, item := range choices {
+			keys = append(keys, item.Key())
+		}
+		if !tt.err && !reflect.DeepEqual(keys, tt.want) {
+			t.Errorf("parseChoices(%q) = %v, want %v", tt.answer, keys, tt.want)
+		}
+	}
+}
+
+// TestLocaleLanguage tests language and region detection from locale variables
+func TestLocaleLanguage(t *testing.T) {
+	tests := []struct {
+		all, lang string
+		want      string
+	}{
+		{lang: "de_DE.UTF-8", want: "de_de"},
+		{all: "fr_FR", lang: "de_DE.UTF-8", want: "fr_fr"},
+		{lang: "C.UTF-8", want: ""},
+		{lang: "sr_RS@latin", want: "sr_rs"},
+		{lang: "ru.UTF-8", want: "ru"},
+		{want: ""},
+	}
+	for _, tt := range tests {
+		t.Setenv("LC_ALL", tt.all)
+		t.Setenv("LC_MESSAGES", "")
+		t.Setenv("LANG", tt.lang)
+		if got := localeLanguage(); got != tt.want {
+			t.Errorf("localeLanguage() with LC_ALL=%q LANG=%q = %q, want %q", tt.all, tt.lang, got, tt.want)
+		}
+	}
+}
+
+// TestConfirmWords tests yes/no words from labels and locale without ambiguous ones
+func TestConfirmWords(t *testing.T) {
+	yes, no := confirmWords("Sure", "Stop", "de")
+	for _, w := range []string{"y", "yes", "sure", "ja", "j"} {
+		if !strings.Contains(","+strings.Join(yes, ",")+",", ","+w+",") {
+			t.Errorf("affirmative words %v should contain %q", yes, w)
+		}
+	}
+	for _, w := range []string{"n", "no", "stop", "nein"} {
+		if !strings.Contains(","+strings.Join(no, ",")+",", ","+w+",") {
+			t.Errorf("negative words %v should contain %q", no, w)
+		}
+	}
+	for _, words := range [][]string{yes, no} {
+		for _, w := range words {
+			if w == "s" {
+				t.Errorf("ambiguous word s should be dropped from %v", words)
+			}
+		}
+	}
+}
+
+// TestLineConfirm tests confirmation read from a line without a terminal
+func TestLineConfirm(t *testing.T) {
+	t.Setenv("LC_ALL", "de_DE.UTF-8")
+	opts, output := lineTerminal(t, "vielleicht\nja\n")
+	ok, err := Confirm(append(opts, WithTitle("Weiter?"))...)
+	if err != nil || !ok {
+		t.Fatalf("Confirm() = %v, %v, want true", ok, err)
+	}
+	if out := output(); !strings.Contains(out, "Weiter?") || !strings.Contains(out, "Fehler: mit Ja oder Nein antworten") {
+		t.Errorf("output should contain title and error:\n%v", out)
+	}
+
+	opts, _ = lineTerminal(t, "\n")
+	if ok, err := Confirm(append(opts, WithDefaultConfirm(true))...); err != nil || !ok {
+		t.Errorf("Confirm() = %v, %v, want default true", ok, err)
+	}
+}
+
+// TestLineFallback tests that prompts read lines when input is not a terminal
+func TestLineFallback(t *testing.T) {
+	t.Setenv(AccessibleEnv, "")
+	opts, _ := lineTerminal(t, "hello\n")
+	if val, err := Input(opts...); err != nil || val != "hello" {
+		t.Errorf("Input() = %q, %v, want hello", val, err)
+	}
+
+	items := []*Item{NewItem("a"), NewItem("b"), NewItem("c"), NewItem("d")}
+	opts, _ = lineTerminal(t, "2\n")
+	if item, err := SelectSingle(append(opts, FromItems(items))...); err != nil || item.Key() != "b" {
+		t.Errorf("SelectSingle() = %v, %v, want b", item, err)
+	}
+
+	opts, _ = lineTerminal(t, "1-2,4\n")
+	selected, err := SelectMultiple(append(opts, FromItems(items))...)
+	if err != nil || len(selected) != 3 || selected[2].Key() != "d" {
+		t.Errorf("SelectMultiple() = %v, %v, want a, b and d", selected, err)
+	}
+
+	opts, _ = lineTerminal(t, "c\n1\n")
+	if item, err := SearchItem(append(opts, FromItems(items))...); err != nil || item.Key() != "c" {
+		t.Errorf("SearchItem() = %v, %v, want c", item, err)
+	}
+}
+
+// TestLineSequentialPrompts tests that prompts sharing a pipe read one answer each
+func TestLineSequentialPrompts(t *testing.T) {
+	in, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := os.Create(filepath.Join(t.TempDir(), "out"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		in.Close()
+		out.Close()
+	})
+	w.WriteString("one\ntwo\ny\n")
+	w.Close()
+	opts := []PromptOption{WithInput(in), WithOutput(out)}
+	for _, want := range []string{"one", "two"} {
+		if val, err := Input(opts...); err != nil || val != want {
+			t.Fatalf("Input() = %q, %v, want %v", val, err, want)
+		}
+	}
+	if ok, err := Confirm(opts...); err != nil || !ok {
+		t.Errorf("Confirm() = %v, %v, want true", ok, err)
+	}
+}
+
+// TestLineSelectPool tests that line selection rejects empty pools and bad items
+func TestLineSelectPool(t *testing.T) {
+	for _, items := range [][]*Item{{}, {NewItem("a"), nil}} {
+		opts, _ := lineTerminal(t, "1\n")
+		if item, err := SelectSingle(append(opts, FromItems(items))...); err == nil {
+			t.Errorf("SelectSingle(%v) = %v, want error", items, item)
+		}
+		opts, _ = lineTerminal(t, "1\n")
+		if selected, err := SelectMultiple(append(opts, FromItems(items))...); err == nil {
+			t.Errorf("SelectMultiple(%v) = %v, want error", items, selected)
+		}
+	}
+}
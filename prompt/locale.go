@@ -0,0 +1,194 @@
+package prompt
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Messages maps English texts of built-in strings to their translations.
+// Texts with a counter have plural forms separated by "|": the English key lists
+// singular and plural ("%v item|%v items") and the translation lists forms in the order
+// of the language plural rule, e.g. one, few and many for Russian.
+// (ai generated comment)
+type Messages map[string]string
+
+// PluralRule returns the index of the plural form used for count n.
+// (ai generated comment)
+type PluralRule func(n int) int
+
+var (
+	catalogue   = map[string]Messages{}
+	pluralRules = map[string]PluralRule{
+		"fr": func(n int) int { return boolIndex(n > 1) },
+		"ru": slavicPlural,
+		"uk": slavicPlural,
+		"pl": func(n int) int {
+			switch {
+			case n == 1:
+				return 0
+			case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+				return 1
+			}
+			return 2
+		},
+	}
+	catalogueMu sync.RWMutex
+)
+
+// englishPlural is the plural rule of English and languages without registered rule:
+// singular for one, plural otherwise.
+// (ai generated comment)
+func englishPlural(n int) int {
+	return boolIndex(n != 1)
+}
+
+// slavicPlural is the plural rule of Russian and Ukrainian with one, few and many forms.
+// (ai generated comment)
+func slavicPlural(n int) int {
+	switch {
+	case n%10 == 1 && n%100 != 11:
+		return 0
+	case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+		return 1
+	}
+	return 2
+}
+
+// boolIndex converts a condition to form index 1 when true and 0 otherwise.
+// (ai generated comment)
+func boolIndex(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// RegisterMessages adds translations for the language (e.g. "de" or "pt_br"),
+// replacing registered translations of the same texts.
+// (ai generated comment)
+func RegisterMessages(language string, messages Messages) {
+	catalogueMu.Lock()
+	defer catalogueMu.Unlock()
+	language = normalizeLanguage(language)
+	if catalogue[language] == nil {
+		catalogue[language] = Messages{}
+	}
+	maps.Copy(catalogue[language], messages)
+}
+
+// RegisterPluralRule sets the plural rule of the language.
+// (ai generated comment)
+func RegisterPluralRule(language string, rule PluralRule) {
+	catalogueMu.Lock()
+	defer catalogueMu.Unlock()
+	pluralRules[normalizeLanguage(language)] = rule
+}
+
+// Languages returns sorted languages with registered translations.
+// (ai generated comment)
+func Languages() []string {
+	catalogueMu.RLock()
+	defer catalogueMu.RUnlock()
+	return slices.Sorted(maps.Keys(catalogue))
+}
+
+// normalizeLanguage converts a language tag like "pt-BR" to catalogue form "pt_br".
+// (ai generated comment)
+func normalizeLanguage(language string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(language), "-", "_"))
+}
+
+// localizer translates built-in strings to a language.
+// Texts without translation are used as is.
+// (ai generated comment)
+type localizer struct {
+	language string // Normalized language tag
+}
+
+// newLocalizer creates a localizer for the language or, if it is empty,
+// for the language of the current locale.
+// (ai generated comment)
+func newLocalizer(language string) localizer {
+	if language == "" {
+		language = localeLanguage()
+	}
+	return localizer{language: normalizeLanguage(language)}
+}
+
+// base returns the base language without region, e.g. "pt" for "pt_br".
+// (ai generated comment)
+func (l localizer) base() string {
+	base, _, _ := strings.Cut(l.language, "_")
+	return base
+}
+
+// lookup returns the translation of the text and the plural rule of the language.
+// Full language tag is tried first, then its base language.
+// (ai generated comment)
+func (l localizer) lookup(text string) (string, PluralRule, bool) {
+	catalogueMu.RLock()
+	defer catalogueMu.RUnlock()
+	base := l.base()
+	for _, language := range []string{l.language, base} {
+		if translated, ok := catalogue[language][text]; ok {
+			rule := pluralRules[language]
+			if rule == nil {
+				rule = pluralRules[base]
+			}
+			return translated, rule, true
+		}
+	}
+	return text, nil, false
+}
+
+// t translates the text and formats it with the arguments.
+// (ai generated comment)
+func (l localizer) t(text string, args ...any) string {
+	translated, _, _ := l.lookup(text)
+	if len(args) == 0 {
+		return translated
+	}
+	return fmt.Sprintf(translated, args...)
+}
+
+// plural translates the text with plural forms, picks the form for count n
+// and formats it with the arguments.
+// (ai generated comment)
+func (l localizer) plural(text string, n int, args ...any) string {
+	translated, rule, ok := l.lookup(text)
+	if !ok || rule == nil {
+		rule = englishPlural
+	}
+	forms := strings.Split(translated, "|")
+	form := forms[min(max(rule(n), 0), len(forms)-1)]
+	return fmt.Sprintf(form, args...)
+}
+
+// wrap returns an error with the translated text wrapping err,
+// so errors.Is keeps matching sentinel errors like ErrCanceled.
+// (ai generated comment)
+func (l localizer) wrap(err error, text string) error {
+	return &localizedError{message: l.t(text), err: err}
+}
+
+// localizedError is an error with a translated message wrapping another error.
+// (ai generated comment)
+type localizedError struct {
+	message string // Translated message
+	err     error  // Wrapped error
+}
+
+// Error returns the translated message.
+// (ai generated comment)
+func (e *localizedError) Error() string {
+	return e.message
+}
+
+// Unwrap returns the wrapped error.
+// (ai generated comment)
+func (e *localizedError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,193 @@
+package prompt
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+)
+
+// TestPluralRules tests plural form selection of bundled rules
+func TestPluralRules(t *testing.T) {
+	tests := []struct {
+		rule PluralRule
+		n    int
+		want int
+	}{
+		{englishPlural, 1, 0},
+		{englishPlural, 0, 1},
+		{englishPlural, 21, 1},
+		{pluralRules["fr"], 0, 0},
+		{pluralRules["fr"], 2, 1},
+		{slavicPlural, 1, 0},
+		{slavicPlural, 21, 0},
+		{slavicPlural, 3, 1},
+		{slavicPlural, 12, 2},
+		{slavicPlural, 25, 2},
+		{pluralRules["pl"], 22, 1},
+		{pluralRules["pl"], 21, 2},
+	}
+	for _, tt := range tests {
+		if got := tt.rule(tt.n); got != tt.want {
+			t.Errorf("rule(%v) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
+
+// TestLocalizer tests translation lookup, region fallback and pluralization
+func TestLocalizer(t *testing.T) {
+	for _, lang := range []string{"de", "fr", "es", "ru"} {
+		if !slices.Contains(Languages(), lang) {
+			t.Errorf("Languages() = %v, want bundled %v", Languages(), lang)
+		}
+	}
+	if got := newLocalizer("de-AT").t("Yes"); got != "Ja" {
+		t.Errorf("t(Yes) for de-AT = %q, want base language translation", got)
+	}
+	if got := newLocalizer("xx").t("show items [%v-%v] of %v", 1, 5, 9); got != "show items [1-5] of 9" {
+		t.Errorf("t() without translation = %q, want English", got)
+	}
+	const text = "%v/%v item filtered|%v/%v items filtered"
+	tests := []struct {
+		language string
+		total    int
+		want     string
+	}{
+		{"en", 1, "1/1 item filtered"},
+		{"en", 5, "1/5 items filtered"},
+		{"ru", 21, "1/21 элемент отфильтрован"},
+		{"ru", 3, "1/3 элемента отфильтровано"},
+		{"ru", 11, "1/11 элементов отфильтровано"},
+	}
+	for _, tt := range tests {
+		if got := newLocalizer(tt.language).plural(text, tt.total, 1, tt.total); got != tt.want {
+			t.Errorf("plural(%v, %v) = %q, want %q", tt.language, tt.total, got, tt.want)
+		}
+	}
+}
+
+// TestRegisterMessages tests custom translations and plural rules
+func TestRegisterMessages(t *testing.T) {
+	RegisterMessages("x-test", Messages{
+		"search item:": "find:",
+		"%v/%v item filtered|%v/%v items filtered": "%v of %v (few)|%v of %v (lots)",
+	})
+	RegisterPluralRule("x_test", func(n int) int { return boolIndex(n > 3) })
+	pb := newTestBuilder(t, ptSearch, WithLanguage("X-Test"))
+	if got := pb.getTitle(); got != "find:" {
+		t.Errorf("getTitle() = %q, want registered translation", got)
+	}
+	if got := pb.localizer().plural("%v/%v item filtered|%v/%v items filtered", 3, 1, 3); got != "1 of 3 (few)" {
+		t.Errorf("plural() = %q, want registered rule", got)
+	}
+	if got := newTestBuilder(t, ptSearch, WithLanguage("x_test"), WithTitle("Pick:")).getTitle(); got != "Pick:" {
+		t.Errorf("getTitle() = %q, want untranslated custom title", got)
+	}
+}
+
+// TestLanguageFromLocale tests that prompt language defaults to the locale
+func TestLanguageFromLocale(t *testing.T) {
+	t.Setenv("LC_ALL", "fr_FR.UTF-8")
+	pb := newTestBuilder(t, ptConfirm)
+	if got := pb.getAffirmative() + "/" + pb.getNegative(); got != "Oui/Non" {
+		t.Errorf("labels = %v, want Oui/Non", got)
+	}
+	if got := newTestBuilder(t, ptConfirm, WithLanguage("en")).getAffirmative(); got != "Yes" {
+		t.Errorf("getAffirmative() = %v, want explicit language to win", got)
+	}
+	RegisterMessages("pt_br", Messages{"Yes": "Sim"})
+	t.Setenv("LC_ALL", "pt_BR.UTF-8")
+	if got := newTestBuilder(t, ptConfirm).getAffirmative(); got != "Sim" {
+		t.Errorf("getAffirmative() = %v, want Sim from the pt_br catalogue", got)
+	}
+}
+
+// TestLocalizedSearch tests translated search view and cancellation error
+func TestLocalizedSearch(t *testing.T) {
+	items := []*Item{NewItem("a"), NewItem("b").WithDisabled("")}
+	sm, err := newSearch(newTestBuilder(t, ptSearch, FromItems(items), WithLanguage("de")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	view := sm.View()
+	for _, want := range []string{"Element suchen:", "Filter: ", "2/2 Elemente gefiltert", "(deaktiviert)", "abbrechen"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View() does not contain %q:\n%v", want, view)
+		}
+	}
+	m, _ := sm.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if err := m.(searchModel).err; !IsCanceled(err) || err.Error() != "Suche abgebrochen" {
+		t.Errorf("err = %v, want translated cancellation", err)
+	}
+}
+
+// TestLocalizedLines tests translated questions, lists and errors of line prompts
+func TestLocalizedLines(t *testing.T) {
+	opts, output := lineTerminal(t, "zzz\nap\n5\n1\n")
+	item, err := SearchItem(append(opts, FromItems(testSearchItems()), WithLanguage("ru"))...)
+	if err != nil || item.Key() != "apple" {
+		t.Fatalf("SearchItem() = %v, %v, want apple", item, err)
+	}
+	for _, want := range []string{"Фильтр (пусто — все элементы): ", `Нет элементов, подходящих под "zzz".`, "2 из 4 элементов:", "Ошибка: введите число от 1 до 2"} {
+		if !strings.Contains(output(), want) {
+			t.Errorf("output does not contain %q:\n%v", want, output())
+		}
+	}
+}
+
+// TestLocalizedSummary tests plural forms of the list summary, translated confirmation key help and answer labels
+func TestLocalizedSummary(t *testing.T) {
+	for n, want := range map[int]string{1: "введено 1 значение", 3: "введено 3 значения", 5: "введено 5 значений"} {
+		lm, err := newListModel(newTestBuilder(t, ptInputList, WithLanguage("ru")))
+		if err != nil {
+			t.Fatal(err)
+		}
+		lm.values = make([]string, n)
+		if !strings.Contains(lm.View(), want) {
+			t.Errorf("View() does not contain %q:\n%v", want, lm.View())
+		}
+	}
+	km := confirmKeyMap(newLocalizer("de"), "Klar", "Nie")
+	help := []string{km.Confirm.Prev.Help().Desc, km.Confirm.Submit.Help().Desc, km.Confirm.Toggle.Help().Desc, km.Confirm.Accept.Help().Desc, km.Confirm.Reject.Help().Desc}
+	if want := []string{"zurück", "bestätigen", "umschalten", "Klar", "Nie"}; !slices.Equal(help, want) {
+		t.Errorf("confirm help = %v, want %v", help, want)
+	}
+}
+
+// TestLocalizedProgress tests translated check progress and plural forms of the suggestion count
+func TestLocalizedProgress(t *testing.T) {
+	av := newAsyncValidation(func(context.Context, string) error { return nil }, 0, huh.ThemeBase16()).withMessages(newLocalizer("de"))
+	av.checking = true
+	if !strings.Contains(av.view(), "prüfe…") {
+		t.Errorf("view() = %q, want translated progress", av.view())
+	}
+	for n, want := range map[int]string{3: "3 подсказки", 5: "5 подсказок"} {
+		var m tea.Model = newTestInputModel(WithLanguage("ru"), WithHeight(8), WithSuggestionProvider(func(prefix string) []string {
+			suggestions := []string{}
+			for i := range n {
+				suggestions = append(suggestions, fmt.Sprint(prefix, i))
+			}
+			return suggestions
+		}))
+		m = typeText(m, "x")
+		if im := m.(inputModel); !strings.Contains(im.viewDropdown(), want) {
+			t.Errorf("viewDropdown() does not contain %q:\n%v", want, im.viewDropdown())
+		}
+	}
+}
+
+// TestLocalizedDefaults tests that only built-in texts are translated, not texts set with options
+func TestLocalizedDefaults(t *testing.T) {
+	pb := newTestBuilder(t, ptConfirm, WithLanguage("de"))
+	if pb.getTitle() != "Bestätigen:" || pb.getAffirmative() != "Ja" {
+		t.Errorf("defaults = %v/%v, want German", pb.getTitle(), pb.getAffirmative())
+	}
+	pb = newTestBuilder(t, ptConfirm, WithLanguage("de"), WithTitle("confirm:"), WithAffirmative("Yes"), WithNegative("No"))
+	if pb.getTitle() != "confirm:" || pb.getAffirmative() != "Yes" || pb.getNegative() != "No" {
+		t.Errorf("user texts = %v/%v/%v, want them untranslated", pb.getTitle(), pb.getAffirmative(), pb.getNegative())
+	}
+}
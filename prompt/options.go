@@ -1,7 +1,10 @@
 package prompt
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/charmbracelet/huh"
 )
@@ -72,7 +75,17 @@ func WithTitle(title string) PromptOption {
 }
 
 func (pb *promptBuilder) getTitle() string {
-	return mustGet(pb, KeyTitle)
+	return localizedDefault(pb, KeyTitle)
+}
+
+// localizedDefault returns the text option as set by the user, or its built-in default
+// translated to the prompt language. Texts set with options are never translated.
+// (ai generated comment)
+func localizedDefault(pb *promptBuilder, key OptionKey[string]) string {
+	if value, ok := getRawValueFrom(pb, key); ok {
+		return value
+	}
+	return pb.localizer().t(mustGet(pb, key))
 }
 
 // WithDescription sets the description text for the prompt.
@@ -219,7 +232,7 @@ func WithAffirmative(affirmative string) PromptOption {
 }
 
 func (pb *promptBuilder) getAffirmative() string {
-	return mustGet(pb, KeyAffirmative)
+	return localizedDefault(pb, KeyAffirmative)
 }
 
 // WithNegative sets the text for the negative (No) button in confirmation prompts.
@@ -232,7 +245,7 @@ func WithNegative(negative string) PromptOption {
 }
 
 func (pb *promptBuilder) getNegative() string {
-	return mustGet(pb, KeyNegative)
+	return localizedDefault(pb, KeyNegative)
 }
 
 // WithCaseSensitiveFilter sets whether search filtering should be case sensitive.
@@ -247,3 +260,530 @@ func WithCaseSensitiveFilter(csf bool) PromptOption {
 func (pb *promptBuilder) getCaseSensitive() bool {
 	return mustGet(pb, KeyCaseSensitiveFilter)
 }
+
+// WithDescriptionSearch sets whether search filter also matches item descriptions.
+// When false (default), only item keys are matched.
+// (ai generated comment)
+func WithDescriptionSearch(enabled bool) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeySearchDescriptions, enabled)
+	}
+}
+
+func (pb *promptBuilder) getSearchDescriptions() bool {
+	return mustGet(pb, KeySearchDescriptions)
+}
+
+// WithSuggestions sets a static list of autocomplete suggestions for input prompts.
+// Suggestions matching the typed prefix are shown as ghost text and in a dropdown.
+// (ai generated comment)
+func WithSuggestions(suggestions ...string) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeySuggestionProvider, StaticSuggestions(suggestions...))
+	}
+}
+
+// WithSuggestionProvider sets a function supplying autocomplete suggestions for input prompts.
+// The provider is called with the current text every time it changes.
+// (ai generated comment)
+func WithSuggestionProvider(provider func(prefix string) []string) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeySuggestionProvider, provider)
+	}
+}
+
+func (pb *promptBuilder) getSuggestionProvider() SuggestionProviderFunc {
+	return mustGet(pb, KeySuggestionProvider)
+}
+
+// WithLayout sets the layout used to display and parse values in date and time prompts.
+// Layout uses the reference time format of the time package.
+// (ai generated comment)
+func WithLayout(layout string) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyLayout, layout)
+	}
+}
+
+func (pb *promptBuilder) getLayout() string {
+	return mustGet(pb, KeyLayout)
+}
+
+// WithLocation sets the time zone used by date and time prompts.
+// Default is the local time zone.
+// (ai generated comment)
+func WithLocation(loc *time.Location) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyLocation, loc)
+	}
+}
+
+func (pb *promptBuilder) getLocation() *time.Location {
+	loc := mustGet(pb, KeyLocation)
+	if loc == nil {
+		return time.Local
+	}
+	return loc
+}
+
+// WithMinTime sets the earliest value accepted by date and time prompts.
+// Zero time means no lower bound.
+// (ai generated comment)
+func WithMinTime(t time.Time) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyMinTime, t)
+	}
+}
+
+func (pb *promptBuilder) getMinTime() time.Time {
+	return mustGet(pb, KeyMinTime)
+}
+
+// WithMaxTime sets the latest value accepted by date and time prompts.
+// Zero time means no upper bound.
+// (ai generated comment)
+func WithMaxTime(t time.Time) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyMaxTime, t)
+	}
+}
+
+func (pb *promptBuilder) getMaxTime() time.Time {
+	return mustGet(pb, KeyMaxTime)
+}
+
+// WithMinDuration sets the shortest value accepted by duration prompts.
+// Default is 0, so negative durations are not allowed unless a negative minimum is set.
+// (ai generated comment)
+func WithMinDuration(d time.Duration) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyMinDuration, d)
+	}
+}
+
+func (pb *promptBuilder) getMinDuration() time.Duration {
+	return mustGet(pb, KeyMinDuration)
+}
+
+// WithMaxDuration sets the longest value accepted by duration prompts.
+// Zero duration means no upper bound.
+// (ai generated comment)
+func WithMaxDuration(d time.Duration) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyMaxDuration, d)
+	}
+}
+
+func (pb *promptBuilder) getMaxDuration() time.Duration {
+	return mustGet(pb, KeyMaxDuration)
+}
+
+// WithStringListValidator sets a validation function for the whole list in list input prompts.
+// The validator is called on submit with all entered values.
+// (ai generated comment)
+func WithStringListValidator(validator func([]string) error) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyStringListValidatorFunc, validator)
+	}
+}
+
+func (pb *promptBuilder) getStringListValidator() StringListValidatorFunc {
+	return mustGet(pb, KeyStringListValidatorFunc)
+}
+
+// WithUniqueValues sets whether list input prompts forbid duplicate values.
+// When false (default), the same value can be entered several times.
+// (ai generated comment)
+func WithUniqueValues(unique bool) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyUniqueValues, unique)
+	}
+}
+
+func (pb *promptBuilder) getUniqueValues() bool {
+	return mustGet(pb, KeyUniqueValues)
+}
+
+// FromMap sets the key/value pairs edited by map editor prompts.
+// The map itself is never modified; the prompt returns a new map.
+// (ai generated comment)
+func FromMap(pairs map[string]string) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyPairs, pairs)
+	}
+}
+
+func (pb *promptBuilder) getPairs() map[string]string {
+	return mustGet(pb, KeyPairs)
+}
+
+// WithKeyValidator sets a validation function for keys in map editor prompts.
+// (ai generated comment)
+func WithKeyValidator(validator func(string) error) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyKeyValidatorFunc, validator)
+	}
+}
+
+func (pb *promptBuilder) getKeyValidator() StringValidatorFunc {
+	return mustGet(pb, KeyKeyValidatorFunc)
+}
+
+// WithValueValidator sets a validation function for values in map editor prompts.
+// (ai generated comment)
+func WithValueValidator(validator func(string) error) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyValueValidatorFunc, validator)
+	}
+}
+
+func (pb *promptBuilder) getValueValidator() StringValidatorFunc {
+	return mustGet(pb, KeyValueValidatorFunc)
+}
+
+// WithMin sets the lowest value of slider prompts.
+// Default is 0.
+// (ai generated comment)
+func WithMin(minValue int) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyMin, minValue)
+	}
+}
+
+func (pb *promptBuilder) getMin() int {
+	return mustGet(pb, KeyMin)
+}
+
+// WithMax sets the highest value of slider prompts.
+// Default is 100.
+// (ai generated comment)
+func WithMax(maxValue int) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyMax, maxValue)
+	}
+}
+
+func (pb *promptBuilder) getMax() int {
+	return mustGet(pb, KeyMax)
+}
+
+// WithStep sets the fine step used by arrow keys in slider prompts.
+// Default is 1.
+// (ai generated comment)
+func WithStep(step int) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyStep, step)
+	}
+}
+
+func (pb *promptBuilder) getStep() int {
+	return mustGet(pb, KeyStep)
+}
+
+// WithCoarseStep sets the coarse step used by paging keys in slider prompts.
+// Zero (default) means ten fine steps.
+// (ai generated comment)
+func WithCoarseStep(step int) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyCoarseStep, step)
+	}
+}
+
+func (pb *promptBuilder) getCoarseStep() int {
+	return mustGet(pb, KeyCoarseStep)
+}
+
+// WithTickLabels sets named ticks shown under the slider bar.
+// Labels are also displayed next to the selected value when it hits a tick.
+// (ai generated comment)
+func WithTickLabels(ticks map[int]string) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyTickLabels, ticks)
+	}
+}
+
+func (pb *promptBuilder) getTickLabels() map[int]string {
+	return mustGet(pb, KeyTickLabels)
+}
+
+// WithInput sets the terminal the prompt reads keys from.
+// Default (nil) is standard input.
+// (ai generated comment)
+func WithInput(in *os.File) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyInput, in)
+	}
+}
+
+func (pb *promptBuilder) getInput() *os.File {
+	return mustGet(pb, KeyInput)
+}
+
+// WithOutput sets the terminal the prompt is rendered to.
+// Default (nil) is standard output.
+// (ai generated comment)
+func WithOutput(out *os.File) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyOutput, out)
+	}
+}
+
+func (pb *promptBuilder) getOutput() *os.File {
+	return mustGet(pb, KeyOutput)
+}
+
+// WithDefaultValue sets the initial value of text based prompts.
+// Input starts with the text, search starts with it as filter,
+// date, time and duration prompts parse it as an expression (e.g. "2025-03-01", "+3d", "90m").
+// (ai generated comment)
+func WithDefaultValue(value string) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyDefaultValue, value)
+	}
+}
+
+func (pb *promptBuilder) getDefaultValue() string {
+	return mustGet(pb, KeyDefaultValue)
+}
+
+// WithDefaultConfirm sets the initial answer of confirmation prompts.
+// (ai generated comment)
+func WithDefaultConfirm(confirmed bool) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyDefaultConfirm, confirmed)
+	}
+}
+
+func (pb *promptBuilder) getDefaultConfirm() bool {
+	return mustGet(pb, KeyDefaultConfirm)
+}
+
+// WithDefaultValues sets the initial values of list input prompts.
+// (ai generated comment)
+func WithDefaultValues(values ...string) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyDefaultValues, values)
+	}
+}
+
+func (pb *promptBuilder) getDefaultValues() []string {
+	return mustGet(pb, KeyDefaultValues)
+}
+
+// WithDefaultNumbers sets the initial value of slider prompts.
+// Range slider uses the first two numbers as low and high values.
+// (ai generated comment)
+func WithDefaultNumbers(values ...int) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyDefaultNumbers, values)
+	}
+}
+
+func (pb *promptBuilder) getDefaultNumbers() []int {
+	return mustGet(pb, KeyDefaultNumbers)
+}
+
+// WithSelected sets preselected items of selection and search prompts.
+// Values are matched against item keys, payloads (by equality) and the items themselves.
+// Single selection and search start with the cursor on the first match.
+// (ai generated comment)
+func WithSelected(values ...any) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeySelected, values)
+	}
+}
+
+func (pb *promptBuilder) getSelected() []any {
+	return mustGet(pb, KeySelected)
+}
+
+// WithAsyncValidator sets an asynchronous validator for input prompts.
+// The check runs after the input stops changing for the debounce delay;
+// stale checks are canceled and submit waits until the latest check passes.
+// (ai generated comment)
+func WithAsyncValidator(validator func(context.Context, string) error) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyAsyncStringValidatorFunc, validator)
+	}
+}
+
+func (pb *promptBuilder) getAsyncStringValidator() AsyncStringValidatorFunc {
+	return mustGet(pb, KeyAsyncStringValidatorFunc)
+}
+
+// WithAsyncItemValidator sets an asynchronous validator of the highlighted item
+// for single selection and search prompts.
+// Submit waits until the check of the highlighted item passes.
+// (ai generated comment)
+func WithAsyncItemValidator(validator func(context.Context, *Item) error) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyAsyncItemValidatorFunc, validator)
+	}
+}
+
+func (pb *promptBuilder) getAsyncItemValidator() AsyncItemValidatorFunc {
+	return mustGet(pb, KeyAsyncItemValidatorFunc)
+}
+
+// WithValidationDebounce sets the delay between the last change and the start of asynchronous validation.
+// (ai generated comment)
+func WithValidationDebounce(d time.Duration) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyValidationDebounce, d)
+	}
+}
+
+func (pb *promptBuilder) getValidationDebounce() time.Duration {
+	return mustGet(pb, KeyValidationDebounce)
+}
+
+// WithWarningConfirmation sets whether submitting a value with validation warning needs confirmation.
+// When true, the first submit shows the warning and the second one proceeds anyway.
+// When false (default), warnings are shown but do not delay submit.
+// (ai generated comment)
+func WithWarningConfirmation(confirm bool) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyConfirmWarnings, confirm)
+	}
+}
+
+func (pb *promptBuilder) getConfirmWarnings() bool {
+	return mustGet(pb, KeyConfirmWarnings)
+}
+
+// WithHistory enables history of submitted values under the prompt ID.
+// Input recalls previous values with up/down arrows, SelectSingle and SearchItem
+// show recently chosen items first. History is stored in DefaultHistory unless
+// another store is set with WithHistoryStore.
+// (ai generated comment)
+func WithHistory(id string) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyHistoryID, id)
+	}
+}
+
+func (pb *promptBuilder) getHistoryID() string {
+	return mustGet(pb, KeyHistoryID)
+}
+
+// WithHistoryStore sets the store used for history enabled with WithHistory.
+// (ai generated comment)
+func WithHistoryStore(history *History) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyHistory, history)
+	}
+}
+
+func (pb *promptBuilder) getHistory() *History {
+	if history := mustGet(pb, KeyHistory); history != nil {
+		return history
+	}
+	return DefaultHistory()
+}
+
+// WithAccessible sets whether the prompt runs in accessible mode for screen readers.
+// Accessible prompts are line oriented: they print questions and read answers
+// without cursor movement or redrawing. Default is taken from CONSOLIO_ACCESSIBLE.
+// (ai generated comment)
+func WithAccessible(accessible bool) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyAccessible, accessible)
+	}
+}
+
+func (pb *promptBuilder) getAccessible() bool {
+	return mustGet(pb, KeyAccessible)
+}
+
+// WithLanguage sets the language of built-in strings like default titles and help,
+// e.g. "de" or "pt_BR". Default is the language of the locale from LC_ALL, LC_MESSAGES or LANG.
+// (ai generated comment)
+func WithLanguage(language string) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyLanguage, language)
+	}
+}
+
+func (pb *promptBuilder) getLanguage() string {
+	return mustGet(pb, KeyLanguage)
+}
+
+// localizer returns the translator of built-in strings for the prompt language.
+// (ai generated comment)
+func (pb *promptBuilder) localizer() localizer {
+	return newLocalizer(pb.getLanguage())
+}
+
+// WithOnChange sets the callback invoked whenever the value of the open prompt changes,
+// e.g. to update a preview. See ChangeHookFunc for value types.
+// (ai generated comment)
+func WithOnChange(hook func(value any)) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyOnChange, hook)
+	}
+}
+
+func (pb *promptBuilder) getOnChange() ChangeHookFunc {
+	return mustGet(pb, KeyOnChange)
+}
+
+// WithOnSubmit sets the callback invoked with the result value before the prompt completes.
+// Returning an error keeps the prompt open and shows the error as message.
+// (ai generated comment)
+func WithOnSubmit(hook func(value any) error) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyOnSubmit, hook)
+	}
+}
+
+func (pb *promptBuilder) getOnSubmit() SubmitHookFunc {
+	return mustGet(pb, KeyOnSubmit)
+}
+
+// WithOnCancel sets the callback invoked when the user cancels or interrupts the prompt.
+// (ai generated comment)
+func WithOnCancel(hook func(err error)) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyOnCancel, hook)
+	}
+}
+
+func (pb *promptBuilder) getOnCancel() CancelHookFunc {
+	return mustGet(pb, KeyOnCancel)
+}
+
+// WithOnValidationError sets the callback invoked when a validator rejects the submitted value.
+// (ai generated comment)
+func WithOnValidationError(hook func(value any, err error)) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyOnValidationError, hook)
+	}
+}
+
+func (pb *promptBuilder) getOnValidationError() ValidationErrorHookFunc {
+	return mustGet(pb, KeyOnValidationError)
+}
+
+// WithRecording appends the prompt session (key presses, terminal resizes, rendered frames and result)
+// to the file as JSON lines for debugging and replay, see LoadSessions. Default is taken from CONSOLIO_RECORD.
+// (ai generated comment)
+func WithRecording(path string) PromptOption {
+	return func(pb *promptBuilder) {
+		setTo(pb, KeyRecordPath, path)
+	}
+}
+
+func (pb *promptBuilder) getRecordPath() string {
+	return mustGet(pb, KeyRecordPath)
+}
+
+// WithDefaults sets the registry the prompt takes default values from,
+// e.g. a LayeredRegistry loaded with LoadDefaults or a ScopedRegistry created with Override.
+// (ai generated comment)
+func WithDefaults(registry DefaultsRegistry) PromptOption {
+	return func(pb *promptBuilder) {
+		if registry != nil {
+			pb.defaultsRegistry = registry
+		}
+	}
+}
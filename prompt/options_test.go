@@ -69,6 +69,12 @@ func TestOptionSetters(t *testing.T) {
 			KeyCaseSensitiveFilter,
 			true,
 		},
+		{
+			"WithUniqueValues",
+			WithUniqueValues(true),
+			KeyUniqueValues,
+			true,
+		},
 	}
 
 	for _, tt := range tests {
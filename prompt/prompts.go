@@ -2,201 +2,311 @@ package prompt
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/huh"
 )
 
 // Input displays a text input prompt and returns the user's string input.
 // It accepts various configuration options through PromptOption functions.
-// Returns the entered string or an error if the prompt fails.
+// When a suggestion provider is configured, autocomplete with a suggestion dropdown is enabled.
+// When an asynchronous validator is configured, input is checked while typing.
+// When history is enabled, previous values are recalled with up/down arrows.
+// Runs the same model as NewInputModel. Returns the entered string or an error if the prompt fails.
 // (ai generated comment)
 func Input(opts ...PromptOption) (string, error) {
-	pb := &promptBuilder{
-		promptType:       ptInput,
-		settings:         map[any]any{},
-		defaultsRegistry: defaultRegistry(),
-	}
-	for _, modify := range opts {
-		modify(pb)
+	pb, err := newPromptBuilder(ptInput, opts...)
+	if err != nil {
+		return "", err
 	}
-	if err := validateRequiredFields(pb); err != nil {
-		return "", fmt.Errorf("failed field validation: %v", err)
+	run := runInputModel
+	if pb.lineOriented() {
+		run = lineInput
 	}
-	val := ""
-	input := huh.NewInput().
-		Title(pb.getTitle()).
-		Description(pb.getDescription()).
-		Prompt(pb.getPrompt()).
-		Placeholder(pb.getPlaceholder()).
-		Validate(pb.getStringValidator()).
-		Value(&val)
-
-	form := huh.NewForm(huh.NewGroup(input)).
-		WithHeight(pb.getHeight()).
-		WithWidth(pb.getWidth()).
-		WithTheme(pb.getTheme())
-	if err := form.Run(); err != nil {
-		return "", err
+	val, err := run(pb)
+	if err != nil {
+		return "", compatAborted(err)
 	}
-
+	pb.recordHistory(val)
 	return val, nil
 }
 
 // SelectSingle displays a single-selection prompt from a list of items.
 // Users can choose one item using arrow keys and enter.
-// Returns the selected Item or an error if selection fails.
+// With history enabled recently chosen items are shown first.
+// Runs the same model as NewSelectModel. Returns the selected Item or an error if selection fails.
 // (ai generated comment)
 func SelectSingle(opts ...PromptOption) (*Item, error) {
-	pb := &promptBuilder{
-		promptType:       ptSelect,
-		settings:         map[any]any{},
-		defaultsRegistry: defaultRegistry(),
-	}
-	for _, modify := range opts {
-		modify(pb)
-	}
-	if err := validateRequiredFields(pb); err != nil {
-		return nil, fmt.Errorf("failed field validation: %v", err)
+	pb, err := newPromptBuilder(ptSelect, opts...)
+	if err != nil {
+		return nil, err
 	}
-	val := new(Item)
+	pb.floatRecentItems()
 	items, err := getFrom(pb, KeyItems)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve selection list: %v", err)
 	}
-	switch len(items) {
-	case 0:
-		return nil, fmt.Errorf("item pool is empty")
-	case 1:
+	if err := checkItemPool(items, false); err != nil {
+		return nil, err
+	}
+	if len(items) == 1 && !items[0].disabled && pb.getAsyncItemValidator() == nil {
 		return items[0], nil
 	}
-	options := huh.NewOptions[*Item]()
-	for i, item := range items {
-		if err := defaultItemValidationFunc(item); err != nil {
-			return nil, fmt.Errorf("bad item list: item %v: %v", i, err)
-		}
-		options = append(options, huh.NewOption(item.Key(), item))
+	run := runSelectModel
+	if pb.lineOriented() {
+		run = lineSelect
 	}
-
-	selector := huh.NewSelect[*Item]().
-		Title(pb.getTitle()).
-		Description(pb.getDescription()).
-		Value(&val).
-		Validate(pb.getItemValidator()).
-		Options(options...)
-
-	form := huh.NewForm(huh.NewGroup(selector)).
-		WithHeight(pb.getHeight()).
-		WithWidth(pb.getWidth()).
-		WithTheme(pb.getTheme())
-	if err := form.Run(); err != nil {
-		return nil, err
+	selected, err := run(pb)
+	if err != nil {
+		return nil, compatAborted(err)
 	}
-
-	return val, nil
+	pb.recordHistory(selected[0].Key())
+	return selected[0], nil
 }
 
 // SelectMultiple displays a multiple-selection prompt from a list of items.
 // Users can select multiple items using spacebar and confirm with enter.
-// Returns a slice of selected Items or an error if selection fails.
+// Without a terminal item numbers and ranges like 1-3,7 are read from a line.
+// Runs the same model as NewSelectMultipleModel. Returns a slice of selected Items or an error if selection fails.
 // (ai generated comment)
 func SelectMultiple(opts ...PromptOption) ([]*Item, error) {
-	pb := &promptBuilder{
-		promptType:       ptSelectMulti,
-		settings:         map[any]any{},
-		defaultsRegistry: defaultRegistry(),
+	pb, err := newPromptBuilder(ptSelectMulti, opts...)
+	if err != nil {
+		return nil, err
 	}
-	for _, modify := range opts {
-		modify(pb)
+	run := runSelectModel
+	if pb.lineOriented() {
+		run = lineSelect
 	}
-	if err := validateRequiredFields(pb); err != nil {
-		return nil, fmt.Errorf("failed field validation: %v", err)
+	selected, err := run(pb)
+	return selected, compatAborted(err)
+}
+
+// Confirm displays a yes/no confirmation prompt.
+// Users can confirm with 'y' or deny with 'n'.
+// Without a terminal the answer is read from a line and may also be a label or a yes/no word of the locale.
+// Runs the same model as NewConfirmModel. Returns a boolean indicating the user's choice or an error if prompt fails.
+// (ai generated comment)
+func Confirm(opts ...PromptOption) (bool, error) {
+	pb, err := newPromptBuilder(ptConfirm, opts...)
+	if err != nil {
+		return false, err
 	}
-	val := new([]*Item)
-	items, err := getFrom(pb, KeyItems)
+	if pb.lineOriented() {
+		return lineConfirm(pb)
+	}
+	val := pb.getDefaultConfirm()
+	final, err := runModel(pb, newConfirmForm(pb, &val))
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve selection list: %v", err)
+		return false, err
 	}
-	switch len(items) {
-	case 0:
-		return nil, fmt.Errorf("item pool is empty")
+	form, ok := final.(*huh.Form)
+	if !ok {
+		return false, fmt.Errorf("unexpected endpoint reached")
 	}
-	options := huh.NewOptions[*Item]()
-	for i, item := range items {
-		if err := defaultItemValidationFunc(item); err != nil {
-			return nil, fmt.Errorf("bad item list: item %v: %v", i, err)
-		}
-		options = append(options, huh.NewOption(item.Key(), item))
+	if form.State != huh.StateCompleted {
+		return false, huh.ErrUserAborted
 	}
+	return val, nil
+}
 
-	selector := huh.NewMultiSelect[*Item]().
+// newConfirmForm creates the huh form of the confirmation prompt storing the answer in val.
+// Answer changes are reported to OnChange callback and OnSubmit callback can veto the answer.
+// The form sends a ResultMsg instead of quitting the program.
+// (ai generated comment)
+func newConfirmForm(pb *promptBuilder, val *bool) *huh.Form {
+	h := pb.hooks()
+	input := huh.NewConfirm().
 		Title(pb.getTitle()).
 		Description(pb.getDescription()).
-		Value(val).
-		Validate(pb.getItemListValidator()).
-		Options(options...)
+		Affirmative(pb.getAffirmative()).
+		Negative(pb.getNegative()).
+		Validate(func(answer bool) error { return h.submit(answer) }).
+		Accessor(&changeAccessor[bool]{value: val, hooks: h})
 
-	form := huh.NewForm(huh.NewGroup(selector)).
+	form := huh.NewForm(huh.NewGroup(input)).
 		WithHeight(pb.getHeight()).
 		WithWidth(pb.getWidth()).
-		WithTheme(pb.getTheme())
-	if err := form.Run(); err != nil {
+		WithTheme(pb.getTheme()).
+		WithKeyMap(confirmKeyMap(pb.localizer(), pb.getAffirmative(), pb.getNegative()))
+	form.SubmitCmd = func() tea.Msg { return ResultMsg{ID: pb.id, Value: *val} }
+	form.CancelCmd = func() tea.Msg {
+		h.cancel(huh.ErrUserAborted)
+		return ResultMsg{ID: pb.id, Err: huh.ErrUserAborted}
+	}
+	return form
+}
+
+// confirmKeyMap returns the huh key map with help texts of the confirmation keys translated.
+// Help of the answer keys shows the affirmative and negative labels.
+// (ai generated comment)
+func confirmKeyMap(msgs localizer, affirmative, negative string) *huh.KeyMap {
+	km := huh.NewDefaultKeyMap()
+	for _, binding := range []*key.Binding{&km.Confirm.Prev, &km.Confirm.Next, &km.Confirm.Submit, &km.Confirm.Toggle} {
+		help := binding.Help()
+		binding.SetHelp(help.Key, msgs.t(help.Desc))
+	}
+	km.Confirm.Accept.SetHelp(km.Confirm.Accept.Help().Key, affirmative)
+	km.Confirm.Reject.SetHelp(km.Confirm.Reject.Help().Key, negative)
+	return km
+}
+
+// InputList displays a prompt collecting an arbitrary number of string values.
+// Each enter adds the typed value, enter on an empty line submits the list.
+// Pasted text is split on commas and newlines.
+// Returns the entered values or an error if the prompt fails.
+// (ai generated comment)
+func InputList(opts ...PromptOption) ([]string, error) {
+	pb, err := newPromptBuilder(ptInputList, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if pb.lineOriented() {
+		return lineInputList(pb)
+	}
+	list, err := newListModel(pb)
+	if err != nil {
 		return nil, err
 	}
+	resultState, err := runModel(pb, list)
+	if err != nil {
+		return nil, err
+	}
+	if finalModel, ok := resultState.(listModel); ok {
+		if finalModel.err != nil {
+			return nil, finalModel.err
+		}
+		return finalModel.values, nil
+	}
+	return nil, fmt.Errorf("unexpected endpoint reached")
+}
 
-	return *val, nil
+// EditMap displays a two-column editor for key/value pairs.
+// Users can add, edit and delete rows; keys and values are validated separately.
+// Returns the resulting map and the diff against the original pairs,
+// or an error if editing is canceled or fails.
+// (ai generated comment)
+func EditMap(opts ...PromptOption) (map[string]string, MapDiff, error) {
+	pb, err := newPromptBuilder(ptEditMap, opts...)
+	if err != nil {
+		return nil, MapDiff{}, err
+	}
+	if pb.lineOriented() {
+		return lineEditMap(pb)
+	}
+	editor, err := newMapModel(pb)
+	if err != nil {
+		return nil, MapDiff{}, err
+	}
+	resultState, err := runModel(pb, editor)
+	if err != nil {
+		return nil, MapDiff{}, err
+	}
+	if finalModel, ok := resultState.(mapModel); ok {
+		if finalModel.err != nil {
+			return nil, MapDiff{}, finalModel.err
+		}
+		result := finalModel.result()
+		return result, DiffMaps(finalModel.original, result), nil
+	}
+	return nil, MapDiff{}, fmt.Errorf("unexpected endpoint reached")
 }
 
-// Confirm displays a yes/no confirmation prompt.
-// Users can confirm with 'y' or deny with 'n'.
-// Returns a boolean indicating the user's choice or an error if prompt fails.
+// Slider displays a prompt selecting a single value on a bar between min and max.
+// Users move the handle with arrow keys (fine step) and paging keys (coarse step).
+// Returns the selected value or an error if the prompt fails.
 // (ai generated comment)
-func Confirm(opts ...PromptOption) (bool, error) {
-	pb := &promptBuilder{
-		promptType:       ptConfirm,
-		settings:         map[any]any{},
-		defaultsRegistry: defaultRegistry(),
+func Slider(opts ...PromptOption) (int, error) {
+	sm, err := runSliderModel(ptSlider, opts...)
+	if err != nil {
+		return 0, err
 	}
-	for _, modify := range opts {
-		modify(pb)
+	return sm.values[0], nil
+}
+
+// SliderRange displays a prompt selecting a range with two handles on a bar.
+// Tab switches the active handle; handles can not pass each other.
+// Returns the low and high values or an error if the prompt fails.
+// (ai generated comment)
+func SliderRange(opts ...PromptOption) (int, int, error) {
+	sm, err := runSliderModel(ptSliderRange, opts...)
+	if err != nil {
+		return 0, 0, err
 	}
-	if err := validateRequiredFields(pb); err != nil {
-		return false, fmt.Errorf("failed field validation: %v", err)
+	return sm.values[0], sm.values[1], nil
+}
+
+// InputDate displays a date prompt with a calendar grid.
+// Users can move the selection with arrow keys or type absolute and relative
+// expressions like "2025-03-01", "tomorrow 9am" or "+3d".
+// Returns the selected date or an error if the prompt fails.
+// (ai generated comment)
+func InputDate(opts ...PromptOption) (time.Time, error) {
+	tm, err := runTimeModel(ptDate, opts...)
+	if err != nil {
+		return time.Time{}, err
 	}
-	val := false
-	input := huh.NewConfirm().
-		Title(pb.getTitle()).
-		Description(pb.getDescription()).
-		Affirmative(pb.getAffirmative()).
-		Negative(pb.getNegative()).
-		Value(&val)
+	return tm.value, nil
+}
 
-	form := huh.NewForm(huh.NewGroup(input)).
-		WithHeight(pb.getHeight()).
-		WithWidth(pb.getWidth()).
-		WithTheme(pb.getTheme())
-	if err := form.Run(); err != nil {
-		return false, err
+// InputTime displays a time of day prompt with adjustable hour, minute and second fields.
+// Users can adjust fields with arrow keys or type expressions like "9:30pm", "noon" or "+2h".
+// Returns the selected time or an error if the prompt fails.
+// (ai generated comment)
+func InputTime(opts ...PromptOption) (time.Time, error) {
+	tm, err := runTimeModel(ptTime, opts...)
+	if err != nil {
+		return time.Time{}, err
 	}
+	return tm.value, nil
+}
 
-	return val, nil
+// InputDuration displays a duration prompt with adjustable day, hour, minute and second fields.
+// Users can adjust fields with arrow keys or type expressions like "90m", "1h30m" or "3d".
+// Returns the selected duration or an error if the prompt fails.
+// (ai generated comment)
+func InputDuration(opts ...PromptOption) (time.Duration, error) {
+	tm, err := runTimeModel(ptDuration, opts...)
+	if err != nil {
+		return 0, err
+	}
+	return tm.duration, nil
 }
 
 // SearchItem displays an interactive search prompt with real-time filtering.
 // Users can type to filter items and navigate with arrow keys.
+// With history enabled recently chosen items are shown first.
+// In accessible mode or without a terminal the filter is asked first and the item is chosen by number from a printed list.
 // Returns the selected Item or an error if search is canceled or fails.
 // (ai generated comment)
 func SearchItem(opts ...PromptOption) (*Item, error) {
-	search, err := newSearch(opts...)
+	pb, err := newPromptBuilder(ptSearch, opts...)
 	if err != nil {
 		return nil, err
 	}
-	prg := tea.NewProgram(search)
-	resultState, err := prg.Run()
+	pb.floatRecentItems()
+	if pb.lineOriented() {
+		item, err := lineSearch(pb)
+		if err != nil {
+			return nil, err
+		}
+		pb.recordHistory(item.Key())
+		return item, nil
+	}
+	search, err := newSearch(pb)
+	if err != nil {
+		return nil, err
+	}
+	resultState, err := runModel(pb, search)
 	if err != nil {
 		return nil, err
 	}
 	if filteredModel, ok := resultState.(searchModel); ok {
+		if filteredModel.err == nil && filteredModel.selectedItem != nil {
+			pb.recordHistory(filteredModel.selectedItem.Key())
+		}
 		return filteredModel.selectedItem, filteredModel.err
 	}
 	return nil, fmt.Errorf("unexpected endpoint reached")
@@ -0,0 +1,335 @@
+package prompt
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	textcursor "github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// RecordEnv is the environment variable with the default session recording file, e.g. CONSOLIO_RECORD=/tmp/prompts.jsonl.
+// (ai generated comment)
+const RecordEnv = "CONSOLIO_RECORD"
+
+// Kinds of session entries.
+// (ai generated comment)
+const (
+	EventStart  = "start"  // Prompt session started
+	EventKey    = "key"    // Key press
+	EventSize   = "size"   // Terminal resize
+	EventResult = "result" // Prompt sent its result
+)
+
+// SessionEvent is one entry of a recorded prompt session.
+// Key and size entries hold the frame rendered right after the event.
+// (ai generated comment)
+type SessionEvent struct {
+	Kind   string      `json:"kind"`             // Kind of entry (EventStart, EventKey, EventSize or EventResult)
+	Prompt string      `json:"prompt,omitempty"` // Prompt type of start entries
+	Key    string      `json:"key,omitempty"`    // Readable key name
+	Type   tea.KeyType `json:"type,omitempty"`   // Key type
+	Runes  string      `json:"runes,omitempty"`  // Typed or pasted text
+	Alt    bool        `json:"alt,omitempty"`    // Whether alt was held
+	Paste  bool        `json:"paste,omitempty"`  // Whether the text was pasted
+	Width  int         `json:"width,omitempty"`  // Terminal width of size entries
+	Height int         `json:"height,omitempty"` // Terminal height of size entries
+	Frame  string      `json:"frame,omitempty"`  // Rendered view after the event
+	Value  string      `json:"value,omitempty"`  // Result value of result entries
+	Error  string      `json:"error,omitempty"`  // Result error of result entries
+}
+
+// msg converts a key or size entry back to the Bubble Tea message.
+// (ai generated comment)
+func (ev SessionEvent) msg() tea.Msg {
+	if ev.Kind == EventSize {
+		return tea.WindowSizeMsg{Width: ev.Width, Height: ev.Height}
+	}
+	return tea.KeyMsg{Type: ev.Type, Runes: []rune(ev.Runes), Alt: ev.Alt, Paste: ev.Paste}
+}
+
+// resultEvent creates the result entry of the result message.
+// (ai generated comment)
+func resultEvent(result ResultMsg) SessionEvent {
+	ev := SessionEvent{Kind: EventResult, Value: formatResultValue(result.Value)}
+	if result.Err != nil {
+		ev.Error = result.Err.Error()
+	}
+	return ev
+}
+
+// formatResultValue formats a result value for the session file: items by key, other values as printed.
+// (ai generated comment)
+func formatResultValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case *Item:
+		return v.Key()
+	case []*Item:
+		keys := make([]string, len(v))
+		for i, item := range v {
+			keys[i] = item.Key()
+		}
+		return strings.Join(keys, ", ")
+	case MapResult:
+		return fmt.Sprint(v.Pairs)
+	}
+	return fmt.Sprint(value)
+}
+
+// sessionWriter writes session entries as JSON lines.
+// Write errors stop recording but never fail the prompt.
+// (ai generated comment)
+type sessionWriter struct {
+	mu  sync.Mutex    // Guards the encoder
+	enc *json.Encoder // Encoder of entries
+	err error         // First write error
+}
+
+// write appends the entry to the session.
+// (ai generated comment)
+func (sw *sessionWriter) write(ev SessionEvent) {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	if sw.err == nil {
+		sw.err = sw.enc.Encode(ev)
+	}
+}
+
+// recordingModel passes messages to a prompt model and records key presses,
+// terminal resizes, rendered frames and the result.
+// (ai generated comment)
+type recordingModel struct {
+	model tea.Model      // Recorded model
+	out   *sessionWriter // Session destination
+}
+
+// Record wraps the model so its session is written to w as JSON lines: a start entry,
+// every key press and terminal resize with the frame rendered after it, and the result.
+// Sessions can be read back with ReadSessions and replayed with Session.Replay.
+// (ai generated comment)
+func Record(model tea.Model, w io.Writer) tea.Model {
+	prompt := ""
+	if m, ok := model.(Model); ok {
+		prompt = string(m.prompt)
+	}
+	return newRecordingModel(model, w, prompt)
+}
+
+// newRecordingModel creates a recording model and writes the start entry.
+// (ai generated comment)
+func newRecordingModel(model tea.Model, w io.Writer, prompt string) recordingModel {
+	rm := recordingModel{model: model, out: &sessionWriter{enc: json.NewEncoder(w)}}
+	rm.out.write(SessionEvent{Kind: EventStart, Prompt: prompt})
+	return rm
+}
+
+// Init initializes the recorded model.
+// (ai generated comment)
+func (rm recordingModel) Init() tea.Cmd {
+	return rm.model.Init()
+}
+
+// Update passes the message to the recorded model and records it.
+// (ai generated comment)
+func (rm recordingModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+	rm.model, cmd = rm.model.Update(msg)
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		rm.out.write(SessionEvent{
+			Kind:  EventKey,
+			Key:   msg.String(),
+			Type:  msg.Type,
+			Runes: string(msg.Runes),
+			Alt:   msg.Alt,
+			Paste: msg.Paste,
+			Frame: rm.model.View(),
+		})
+	case tea.WindowSizeMsg:
+		rm.out.write(SessionEvent{Kind: EventSize, Width: msg.Width, Height: msg.Height, Frame: rm.model.View()})
+	case ResultMsg:
+		rm.out.write(resultEvent(msg))
+	}
+	return rm, cmd
+}
+
+// View renders the recorded model.
+// (ai generated comment)
+func (rm recordingModel) View() string {
+	return rm.model.View()
+}
+
+// openRecording opens the session file for appending, so consecutive prompts are kept.
+// (ai generated comment)
+func openRecording(path string) (*os.File, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create recording directory: %v", err)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open recording: %v", err)
+	}
+	return f, nil
+}
+
+// Session is a recorded prompt session.
+// (ai generated comment)
+type Session struct {
+	Prompt string         // Prompt type
+	Events []SessionEvent // Key presses and terminal resizes in order
+	Result *SessionEvent  // Result entry, nil if the session did not complete
+}
+
+// ReadSessions reads sessions recorded by Record or with WithRecording from JSON lines.
+// (ai generated comment)
+func ReadSessions(r io.Reader) ([]*Session, error) {
+	sessions := []*Session{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var ev SessionEvent
+		if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+			return nil, fmt.Errorf("line %v: %v", line, err)
+		}
+		if ev.Kind == EventStart {
+			sessions = append(sessions, &Session{Prompt: ev.Prompt})
+			continue
+		}
+		if len(sessions) == 0 {
+			return nil, fmt.Errorf("line %v: %v entry before session start", line, ev.Kind)
+		}
+		session := sessions[len(sessions)-1]
+		switch ev.Kind {
+		case EventKey, EventSize:
+			session.Events = append(session.Events, ev)
+		case EventResult:
+			session.Result = &ev
+		default:
+			return nil, fmt.Errorf("line %v: unknown entry kind %q", line, ev.Kind)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read sessions: %v", err)
+	}
+	return sessions, nil
+}
+
+// LoadSessions reads sessions from a recording file.
+// (ai generated comment)
+func LoadSessions(path string) ([]*Session, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open recording: %v", err)
+	}
+	defer f.Close()
+	return ReadSessions(f)
+}
+
+// ReplayResult is the outcome of a replayed session.
+// (ai generated comment)
+type ReplayResult struct {
+	Frames   []string   // Frames rendered after each replayed event
+	Result   *ResultMsg // Result sent by the model, nil if it sent none
+	Diverged int        // Index of the first event whose frame differs from the recording, -1 if none
+}
+
+// replayable is implemented by prompt models with timers (cursor blinking, validation debounce, spinner).
+// replayMode returns the model with timers that do not wait for wall-clock time.
+// (ai generated comment)
+type replayable interface {
+	replayMode() tea.Model
+}
+
+// replayMode returns the model prepared for session replay if it has timers.
+// (ai generated comment)
+func replayMode(model tea.Model) tea.Model {
+	if r, ok := model.(replayable); ok {
+		return r.replayMode()
+	}
+	return model
+}
+
+// steadyCursor makes the cursor of the text input always shown instead of blinking.
+// (ai generated comment)
+func steadyCursor(ti *textinput.Model) {
+	ti.Cursor.SetMode(textcursor.CursorStatic)
+}
+
+// Replay feeds recorded events through the model, which should be built with the same options
+// as the recorded prompt, e.g. with NewSearchModel. Prompt models are switched to replay mode:
+// the cursor does not blink, asynchronous checks start without debounce and the spinner stands still.
+// After every event its commands run to completion one at a time in the order they were issued,
+// so replay gives the same frames on every run. Frames recorded while the cursor blinked off
+// or while a check was still running may differ from the replayed ones.
+// (ai generated comment)
+func (s *Session) Replay(model tea.Model) *ReplayResult {
+	rr := &ReplayResult{Diverged: -1}
+	model = replayMode(model)
+	model = rr.resolve(model, model.Init())
+	for i, ev := range s.Events {
+		if rr.Result != nil {
+			break
+		}
+		var cmd tea.Cmd
+		model, cmd = model.Update(ev.msg())
+		frame := model.View()
+		rr.Frames = append(rr.Frames, frame)
+		if frame != ev.Frame && rr.Diverged < 0 {
+			rr.Diverged = i
+		}
+		model = rr.resolve(model, cmd)
+	}
+	return rr
+}
+
+// resolve executes the command and the commands it leads to one at a time, first in first out,
+// and passes their messages to the model. Batches are expanded in order. Result message is stored instead.
+// (ai generated comment)
+func (rr *ReplayResult) resolve(model tea.Model, cmd tea.Cmd) tea.Model {
+	queue := []tea.Cmd{cmd}
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		if next == nil {
+			continue
+		}
+		switch msg := next().(type) {
+		case nil, tea.QuitMsg, tea.InterruptMsg:
+		case tea.BatchMsg:
+			queue = append(queue, msg...)
+		case ResultMsg:
+			if rr.Result == nil {
+				rr.Result = &msg
+			}
+		default:
+			var cmd tea.Cmd
+			model, cmd = model.Update(msg)
+			queue = append(queue, cmd)
+		}
+	}
+	return model
+}
+
+// Matches reports whether replay reproduced all recorded frames and the recorded result.
+// (ai generated comment)
+func (rr *ReplayResult) Matches(s *Session) bool {
+	if rr.Diverged >= 0 || len(rr.Frames) != len(s.Events) {
+		return false
+	}
+	if rr.Result == nil || s.Result == nil {
+		return rr.Result == nil && s.Result == nil
+	}
+	return resultEvent(*rr.Result) == *s.Result
+}
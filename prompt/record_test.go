@@ -0,0 +1,152 @@
+package prompt
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// recordSearch records a search session over the items and returns the session file contents
+func recordSearch(t *testing.T, items []string) string {
+	t.Helper()
+	model, err := NewSearchModel(FromItems(searchItems(items)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := &bytes.Buffer{}
+	m := Record(model, buf)
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 60, Height: 20})
+	m = typeText(m, "an")
+	m, cmd := sendKeys(m, tea.KeyMsg{Type: tea.KeyBackspace}, tea.KeyMsg{Type: tea.KeyDown}, tea.KeyMsg{Type: tea.KeyEnter})
+	m.Update(awaitResult(t, m, cmd))
+	return buf.String()
+}
+
+// searchItems creates items with the keys
+func searchItems(keys []string) []*Item {
+	items := []*Item{}
+	for _, key := range keys {
+		items = append(items, NewItem(key))
+	}
+	return items
+}
+
+// TestRecordReplay tests that a recorded search session replays to the same frames and result
+func TestRecordReplay(t *testing.T) {
+	items := []string{"apple", "banana", "mango", "cherry"}
+	sessions, err := ReadSessions(strings.NewReader(recordSearch(t, items)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sessions) != 1 || sessions[0].Prompt != string(ptSearch) {
+		t.Fatalf("sessions = %+v, want one search session", sessions)
+	}
+	s := sessions[0]
+	if len(s.Events) != 6 || s.Events[0].Kind != EventSize || s.Events[1].Key != "a" {
+		t.Fatalf("events = %+v, want resize and five keys", s.Events)
+	}
+	if s.Result == nil || s.Result.Value != "banana" {
+		t.Fatalf("recorded result = %+v, want banana", s.Result)
+	}
+	model, _ := NewSearchModel(FromItems(searchItems(items)))
+	rr := s.Replay(model)
+	if !rr.Matches(s) {
+		t.Errorf("replay diverged at event %v, result %+v", rr.Diverged, rr.Result)
+	}
+	if rr.Result == nil || rr.Result.ID != model.ID() {
+		t.Errorf("replay result = %+v, want result of model %v", rr.Result, model.ID())
+	}
+	model, _ = NewSearchModel(FromItems(searchItems([]string{"apple", "mango"})))
+	if rr := s.Replay(model); rr.Matches(s) || rr.Diverged != 0 {
+		t.Errorf("replay over other items diverged at %v, want 0", rr.Diverged)
+	}
+}
+
+// TestLoadSessions tests that recordings accumulate in a file and malformed entries are rejected
+func TestLoadSessions(t *testing.T) {
+	path := t.TempDir() + "/debug/session.jsonl"
+	for range 2 {
+		f, err := openRecording(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.WriteString(recordSearch(t, []string{"banana", "mango"}))
+		f.Close()
+	}
+	sessions, err := LoadSessions(path)
+	if err != nil || len(sessions) != 2 {
+		t.Fatalf("LoadSessions() = %v sessions, %v, want 2", len(sessions), err)
+	}
+	for _, input := range []string{`{"kind":"key","key":"a"}`, "{\"kind\":\"start\"}\n{\"kind\":\"jump\"}", "{"} {
+		if _, err := ReadSessions(strings.NewReader(input)); err == nil {
+			t.Errorf("ReadSessions(%q) should fail", input)
+		}
+	}
+}
+
+// TestRecordBlockingPrompts tests that confirm and plain single selection run by the blocking API are recorded
+func TestRecordBlockingPrompts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	pb := newTestBuilder(t, ptConfirm, append(pipeTerminal(t, "y"), WithRecording(path))...)
+	val := false
+	if _, err := runModel(pb, newConfirmForm(pb, &val)); err != nil || !val {
+		t.Fatalf("confirm = %v, %v, want true", val, err)
+	}
+	pb = newTestBuilder(t, ptSelect, append(pipeTerminal(t, "\x1b[B\r"), FromItems(searchItems([]string{"apple", "pear"})), WithRecording(path))...)
+	if selected, err := runSelectModel(pb); err != nil || selected[0].Key() != "pear" {
+		t.Fatalf("select = %v, %v, want pear", selected, err)
+	}
+	sessions, err := LoadSessions(path)
+	if err != nil || len(sessions) != 2 {
+		t.Fatalf("LoadSessions() = %v sessions, %v, want 2", len(sessions), err)
+	}
+	for i, want := range []struct{ prompt, value string }{{string(ptConfirm), "true"}, {string(ptSelect), "pear"}} {
+		s := sessions[i]
+		if s.Prompt != want.prompt || len(s.Events) == 0 || s.Result == nil || s.Result.Value != want.value {
+			t.Errorf("session %v = %+v, result %+v, want %v with result %v", i, s, s.Result, want.prompt, want.value)
+		}
+	}
+}
+
+// keyEvents returns key entries typing the text
+func keyEvents(text string) []SessionEvent {
+	events := []SessionEvent{}
+	for _, r := range text {
+		events = append(events, SessionEvent{Kind: EventKey, Type: tea.KeyRunes, Runes: string(r)})
+	}
+	return events
+}
+
+// TestReplayTimers tests that replay waits for debounced asynchronous checks regardless of their duration
+func TestReplayTimers(t *testing.T) {
+	slow := func(_ context.Context, s string) error {
+		time.Sleep(60 * time.Millisecond)
+		if s == "bad" {
+			return errors.New("bad is taken")
+		}
+		return nil
+	}
+	enter := SessionEvent{Kind: EventKey, Type: tea.KeyEnter}
+	backspace := SessionEvent{Kind: EventKey, Type: tea.KeyBackspace}
+	events := append(keyEvents("bad"), enter, backspace, backspace, backspace)
+	s := &Session{Prompt: string(ptInput), Events: append(append(events, keyEvents("ok")...), enter)}
+	for range 3 {
+		model, err := NewInputModel(WithAsyncValidator(slow), WithValidationDebounce(time.Second))
+		if err != nil {
+			t.Fatal(err)
+		}
+		rr := s.Replay(model)
+		if rr.Result == nil || rr.Result.Value != "ok" {
+			t.Fatalf("replay result = %+v, want ok", rr.Result)
+		}
+		if !strings.Contains(rr.Frames[3], "bad is taken") {
+			t.Errorf("frame after enter should show the check result:\n%v", rr.Frames[3])
+		}
+	}
+}
@@ -3,8 +3,9 @@ package prompt
 import (
 	"fmt"
 	"maps"
-
-	"github.com/charmbracelet/huh"
+	"os"
+	"sync"
+	"time"
 )
 
 // DefaultsRegistry manages default values for prompt options across different prompt types.
@@ -31,9 +32,12 @@ type DefaultsRegistry interface {
 // mapDefaultsRegistry implements DefaultsRegistry using in-memory maps.
 // This is the default implementation used by the library.
 // It stores defaults in a nested map structure: key -> promptType -> value.
+// It is safe for concurrent use; clones share the maps until either side is written to.
 // (ai generated comment)
 type mapDefaultsRegistry struct {
-	defaults map[any]map[promptType]any
+	mu       sync.RWMutex               // Guards defaults and shared
+	defaults map[any]map[promptType]any // Values by option key and prompt type
+	shared   bool                       // Whether defaults are shared with a clone and must be copied before writing
 }
 
 // newMapDefaultsRegistry creates a new registry instance with empty maps.
@@ -50,6 +54,8 @@ func newMapDefaultsRegistry() *mapDefaultsRegistry {
 // Returns nil and false if either the key or prompt type is not found.
 // (ai generated comment)
 func (r *mapDefaultsRegistry) GetDefault(key any, pt promptType) (any, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 	typeDefaults, exists := r.defaults[key]
 	if !exists {
 		return nil, false
@@ -60,26 +66,43 @@ func (r *mapDefaultsRegistry) GetDefault(key any, pt promptType) (any, bool) {
 
 // RegisterDefault registers a default value for a specific key and prompt type.
 // Creates the nested map structure if it doesn't already exist.
+// Maps shared with a clone are copied first.
 // This method is aliased as SetDefault in the interface.
 // (ai generated comment)
 func (r *mapDefaultsRegistry) SetDefault(key any, pt promptType, value any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.shared {
+		r.defaults = copyDefaults(r.defaults)
+		r.shared = false
+	}
 	if r.defaults[key] == nil {
 		r.defaults[key] = make(map[promptType]any)
 	}
 	r.defaults[key][pt] = value
 }
 
-// Clone creates a deep copy of the registry.
-// Uses the maps.Copy function to ensure proper copying of nested map structures.
-// Returns a new DefaultsRegistry instance with the same default values.
+// Clone creates a copy-on-write copy of the registry.
+// Both registries share the maps until one of them sets a value, so cloning is cheap
+// and prompts can take a snapshot of their defaults when they start.
 // (ai generated comment)
 func (r *mapDefaultsRegistry) Clone() DefaultsRegistry {
-	newRegistry := newMapDefaultsRegistry()
-	for key, typeMap := range r.defaults {
-		newRegistry.defaults[key] = make(map[promptType]any)
-		maps.Copy(newRegistry.defaults[key], typeMap)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.shared = true
+	return &mapDefaultsRegistry{defaults: r.defaults, shared: true}
+}
+
+// copyDefaults creates a deep copy of nested default maps.
+// Uses the maps.Copy function to ensure proper copying of nested map structures.
+// (ai generated comment)
+func copyDefaults(defaults map[any]map[promptType]any) map[any]map[promptType]any {
+	copied := make(map[any]map[promptType]any, len(defaults))
+	for key, typeMap := range defaults {
+		copied[key] = make(map[promptType]any, len(typeMap))
+		maps.Copy(copied[key], typeMap)
 	}
-	return newRegistry
+	return copied
 }
 
 // NewDefaultsRegistry creates a new DefaultsRegistry instance.
@@ -95,14 +118,9 @@ func NewDefaultsRegistry() DefaultsRegistry {
 // (ai generated comment)
 func defaultRegistry() DefaultsRegistry {
 	registry := newMapDefaultsRegistry()
+	theme := defaultTheme()
 	// Initialize defaults for all prompt types
-	for _, ptType := range []promptType{
-		ptInput,
-		ptSelect,
-		ptSelectMulti,
-		ptConfirm,
-		ptSearch,
-	} {
+	for _, ptType := range promptTypes {
 		// Set type-specific defaults
 		switch ptType {
 		case ptInput:
@@ -110,6 +128,7 @@ func defaultRegistry() DefaultsRegistry {
 			registry.SetDefault(KeyPrompt, ptType, "")
 			registry.SetDefault(KeyPlaceholder, ptType, "")
 			registry.SetDefault(KeyStringValidatorFunc, ptType, defaultStringValidatorFunc)
+			registry.SetDefault(KeySuggestionProvider, ptType, defaultSuggestionProviderFunc)
 		case ptSelect:
 			registry.SetDefault(KeyTitle, ptType, "select one item:")
 			registry.SetDefault(KeyItems, ptType, []*Item{})
@@ -128,12 +147,85 @@ func defaultRegistry() DefaultsRegistry {
 			registry.SetDefault(KeyItems, ptType, []*Item{})
 			registry.SetDefault(KeyItemValidatorFunc, ptType, defaultItemValidatorFunc)
 			registry.SetDefault(KeyCaseSensitiveFilter, ptType, false)
+			registry.SetDefault(KeySearchDescriptions, ptType, false)
+			registry.SetDefault(KeySelected, ptType, []any{})
+		case ptDate:
+			registry.SetDefault(KeyTitle, ptType, "select date:")
+			registry.SetDefault(KeyLayout, ptType, time.DateOnly)
+		case ptTime:
+			registry.SetDefault(KeyTitle, ptType, "select time:")
+			registry.SetDefault(KeyLayout, ptType, "15:04")
+		case ptDuration:
+			registry.SetDefault(KeyTitle, ptType, "select duration:")
+			registry.SetDefault(KeyMinDuration, ptType, time.Duration(0))
+			registry.SetDefault(KeyMaxDuration, ptType, time.Duration(0))
+			registry.SetDefault(KeyStringValidatorFunc, ptType, defaultStringValidatorFunc)
+		case ptInputList:
+			registry.SetDefault(KeyTitle, ptType, "enter values:")
+			registry.SetDefault(KeyPrompt, ptType, "> ")
+			registry.SetDefault(KeyPlaceholder, ptType, "")
+			registry.SetDefault(KeyStringValidatorFunc, ptType, defaultStringValidatorFunc)
+			registry.SetDefault(KeyStringListValidatorFunc, ptType, defaultStringListValidatorFunc)
+			registry.SetDefault(KeyUniqueValues, ptType, false)
+		case ptEditMap:
+			registry.SetDefault(KeyTitle, ptType, "edit pairs:")
+			registry.SetDefault(KeyPairs, ptType, map[string]string{})
+			registry.SetDefault(KeyKeyValidatorFunc, ptType, defaultStringValidatorFunc)
+			registry.SetDefault(KeyValueValidatorFunc, ptType, defaultStringValidatorFunc)
+		case ptSlider:
+			registry.SetDefault(KeyTitle, ptType, "select value:")
+		case ptSliderRange:
+			registry.SetDefault(KeyTitle, ptType, "select range:")
+		}
+		switch ptType {
+		case ptInput:
+			registry.SetDefault(KeyAsyncStringValidatorFunc, ptType, AsyncStringValidatorFunc(nil))
+			registry.SetDefault(KeyValidationDebounce, ptType, defaultValidationDebounce)
+		case ptSelect, ptSearch:
+			registry.SetDefault(KeyAsyncItemValidatorFunc, ptType, AsyncItemValidatorFunc(nil))
+			registry.SetDefault(KeyValidationDebounce, ptType, defaultValidationDebounce)
+		}
+		switch ptType {
+		case ptInput, ptSearch, ptDate, ptTime, ptDuration:
+			registry.SetDefault(KeyDefaultValue, ptType, "")
+		case ptSelect, ptSelectMulti:
+			registry.SetDefault(KeySelected, ptType, []any{})
+		case ptConfirm:
+			registry.SetDefault(KeyDefaultConfirm, ptType, false)
+		case ptInputList:
+			registry.SetDefault(KeyDefaultValues, ptType, []string{})
+		}
+		switch ptType {
+		case ptSlider, ptSliderRange:
+			registry.SetDefault(KeyDefaultNumbers, ptType, []int{})
+			registry.SetDefault(KeyMin, ptType, 0)
+			registry.SetDefault(KeyMax, ptType, 100)
+			registry.SetDefault(KeyStep, ptType, 1)
+			registry.SetDefault(KeyCoarseStep, ptType, 0)
+			registry.SetDefault(KeyTickLabels, ptType, map[int]string{})
+		case ptDate, ptTime:
+			registry.SetDefault(KeyLocation, ptType, time.Local)
+			registry.SetDefault(KeyMinTime, ptType, time.Time{})
+			registry.SetDefault(KeyMaxTime, ptType, time.Time{})
+			registry.SetDefault(KeyStringValidatorFunc, ptType, defaultStringValidatorFunc)
 		}
 		// Set common defaults that apply to all prompt types
 		registry.SetDefault(KeyDescription, ptType, "")
 		registry.SetDefault(KeyWidth, ptType, 0)
 		registry.SetDefault(KeyHeight, ptType, 0)
-		registry.SetDefault(KeyTheme, ptType, huh.ThemeBase16())
+		registry.SetDefault(KeyTheme, ptType, theme)
+		registry.SetDefault(KeyInput, ptType, (*os.File)(nil))
+		registry.SetDefault(KeyOutput, ptType, (*os.File)(nil))
+		registry.SetDefault(KeyConfirmWarnings, ptType, false)
+		registry.SetDefault(KeyHistoryID, ptType, "")
+		registry.SetDefault(KeyHistory, ptType, (*History)(nil))
+		registry.SetDefault(KeyAccessible, ptType, accessibleFromEnv())
+		registry.SetDefault(KeyLanguage, ptType, "")
+		registry.SetDefault(KeyOnChange, ptType, ChangeHookFunc(nil))
+		registry.SetDefault(KeyOnSubmit, ptType, SubmitHookFunc(nil))
+		registry.SetDefault(KeyOnCancel, ptType, CancelHookFunc(nil))
+		registry.SetDefault(KeyOnValidationError, ptType, ValidationErrorHookFunc(nil))
+		registry.SetDefault(KeyRecordPath, ptType, os.Getenv(RecordEnv))
 	}
 
 	return registry
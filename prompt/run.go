@@ -0,0 +1,41 @@
+package prompt
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// programOptions returns Bubble Tea options for the terminal configured in the prompt builder.
+// (ai generated comment)
+func (pb *promptBuilder) programOptions() []tea.ProgramOption {
+	opts := []tea.ProgramOption{}
+	if in := pb.getInput(); in != nil {
+		opts = append(opts, tea.WithInput(in))
+	}
+	if out := pb.getOutput(); out != nil {
+		opts = append(opts, tea.WithOutput(out))
+	}
+	return opts
+}
+
+// runModel runs a prompt model on the terminal configured in the prompt builder
+// until it sends its result, recording the session if configured. Returns the final model state.
+// (ai generated comment)
+func runModel(pb *promptBuilder, model tea.Model) (tea.Model, error) {
+	var root tea.Model = standaloneModel{model: model}
+	if path := pb.getRecordPath(); path != "" {
+		f, err := openRecording(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		root = newRecordingModel(root, f, string(pb.promptType))
+	}
+	final, err := tea.NewProgram(root, pb.programOptions()...).Run()
+	if err != nil {
+		return nil, err
+	}
+	if rm, ok := final.(recordingModel); ok {
+		final = rm.model
+	}
+	return final.(standaloneModel).model, nil
+}
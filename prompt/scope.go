@@ -0,0 +1,117 @@
+package prompt
+
+import (
+	"context"
+	"maps"
+	"sync"
+)
+
+// ScopedRegistry is a DefaultsRegistry overriding defaults of a parent registry for one session,
+// e.g. a user of a TUI server, without changing the parent or other sessions.
+// Values not overridden are taken from the parent at the time they are read.
+// It is safe for concurrent use.
+// (ai generated comment)
+type ScopedRegistry struct {
+	mu        sync.RWMutex     // Guards overrides
+	parent    DefaultsRegistry // Registry providing values that are not overridden
+	overrides *defaultsLayer   // Overridden values
+}
+
+// Override creates a scoped registry over the parent (built-in defaults if nil).
+// Overrides are given like in defaults files: sections named after prompt types (or "all")
+// holding option names and values, e.g. {"all": {"width": 60}, "input": {"title": "Name:"}}.
+// (ai generated comment)
+func Override(parent DefaultsRegistry, overrides map[string]map[string]any) (*ScopedRegistry, error) {
+	if parent == nil {
+		parent = defaultRegistry()
+	}
+	doc := map[string]any{}
+	for section, options := range overrides {
+		normalized := map[string]any{}
+		for option, value := range options {
+			normalized[option] = normalizeConfigValue(value)
+		}
+		doc[section] = normalized
+	}
+	l, err := loadLayer(doc, "scope")
+	if err != nil {
+		return nil, err
+	}
+	return &ScopedRegistry{parent: parent, overrides: l}, nil
+}
+
+// GetDefault retrieves the overridden value for the key and prompt type or the value of the parent.
+// (ai generated comment)
+func (r *ScopedRegistry) GetDefault(key any, pt promptType) (any, bool) {
+	r.mu.RLock()
+	value, ok := r.overrides.values.GetDefault(key, pt)
+	r.mu.RUnlock()
+	if ok {
+		return value, true
+	}
+	return r.parent.GetDefault(key, pt)
+}
+
+// SetDefault overrides the default value in this scope only.
+// (ai generated comment)
+func (r *ScopedRegistry) SetDefault(key any, pt promptType, value any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.overrides.values.SetDefault(key, pt, value)
+}
+
+// Set converts a config value and overrides it for the section (prompt type name or "all") and option name.
+// (ai generated comment)
+func (r *ScopedRegistry) Set(section, option string, value any) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.overrides.set(section, option, normalizeConfigValue(value))
+}
+
+// Clone creates a snapshot of the scope and its parent. Values are copied on write.
+// (ai generated comment)
+func (r *ScopedRegistry) Clone() DefaultsRegistry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	raw := map[string]map[string]any{}
+	for section, options := range r.overrides.raw {
+		raw[section] = maps.Clone(options)
+	}
+	return &ScopedRegistry{
+		parent: r.parent.Clone(),
+		overrides: &defaultsLayer{
+			values: r.overrides.values.Clone().(*mapDefaultsRegistry),
+			raw:    raw,
+			source: r.overrides.source,
+		},
+	}
+}
+
+// defaultsContextKey is the context key of the defaults registry.
+// (ai generated comment)
+type defaultsContextKey struct{}
+
+// ContextWithDefaults returns a copy of the context carrying the registry,
+// so prompts of a session started with WithDefaultsFrom use it.
+// (ai generated comment)
+func ContextWithDefaults(ctx context.Context, registry DefaultsRegistry) context.Context {
+	return context.WithValue(ctx, defaultsContextKey{}, registry)
+}
+
+// DefaultsFromContext returns the registry carried by the context.
+// (ai generated comment)
+func DefaultsFromContext(ctx context.Context) (DefaultsRegistry, bool) {
+	registry, ok := ctx.Value(defaultsContextKey{}).(DefaultsRegistry)
+	return registry, ok && registry != nil
+}
+
+// WithDefaultsFrom sets the registry carried by the context (see ContextWithDefaults)
+// as the registry the prompt takes default values from. Without one built-in defaults are used.
+// (ai generated comment)
+func WithDefaultsFrom(ctx context.Context) PromptOption {
+	return func(pb *promptBuilder) {
+		if registry, ok := DefaultsFromContext(ctx); ok {
+			pb.defaultsRegistry = registry
+		}
+	}
+}
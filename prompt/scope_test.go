@@ -0,0 +1,111 @@
+package prompt
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// TestScopedRegistry tests that scoped overrides leave the parent and other scopes unchanged
+func TestScopedRegistry(t *testing.T) {
+	parent := NewLayeredRegistry()
+	alice, err := Override(parent, map[string]map[string]any{"all": {"width": 60}, "input": {"title": "Alice:"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bob, err := Override(parent, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := bob.Set("input", "title", "Bob:"); err != nil {
+		t.Fatal(err)
+	}
+	parent.SetDefault(KeyPlaceholder, ptInput, "shared")
+	tests := []struct {
+		registry DefaultsRegistry
+		key      any
+		want     any
+	}{
+		{alice, KeyTitle, "Alice:"},
+		{alice, KeyWidth, 60},
+		{alice, KeyPlaceholder, "shared"},
+		{bob, KeyTitle, "Bob:"},
+		{bob, KeyWidth, 0},
+		{parent, KeyTitle, "user input:"},
+	}
+	for i, tt := range tests {
+		if got, _ := tt.registry.GetDefault(tt.key, ptInput); got != tt.want {
+			t.Errorf("%v: %v = %v, want %v", i, tt.key, got, tt.want)
+		}
+	}
+	if _, err := Override(nil, map[string]map[string]any{"input": {"unknown": 1}}); err == nil {
+		t.Error("Override() with unknown option should fail")
+	}
+}
+
+// TestDefaultsFromContext tests that prompts take the registry carried by the context
+func TestDefaultsFromContext(t *testing.T) {
+	scope, _ := Override(nil, map[string]map[string]any{"input": {"title": "Session:"}})
+	ctx := ContextWithDefaults(context.Background(), scope)
+	pb, err := newPromptBuilder(ptInput, WithDefaultsFrom(ctx))
+	if err != nil || pb.getTitle() != "Session:" {
+		t.Fatalf("title = %v, %v, want scoped title", pb.getTitle(), err)
+	}
+	pb, _ = newPromptBuilder(ptInput, WithDefaultsFrom(context.Background()))
+	if pb.getTitle() != "user input:" {
+		t.Errorf("title without registry in context = %v, want built-in", pb.getTitle())
+	}
+}
+
+// TestPromptSnapshot tests that defaults changed after prompt start do not affect the prompt
+func TestPromptSnapshot(t *testing.T) {
+	registry := NewDefaultsRegistry()
+	registry.SetDefault(KeyTitle, ptInput, "before")
+	pb, err := newPromptBuilder(ptInput, WithDefaults(registry))
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry.SetDefault(KeyTitle, ptInput, "after")
+	if pb.getTitle() != "before" {
+		t.Errorf("title = %v, want snapshot taken at start", pb.getTitle())
+	}
+	snapshot := registry.Clone()
+	snapshot.SetDefault(KeyTitle, ptInput, "snapshot")
+	if got, _ := registry.GetDefault(KeyTitle, ptInput); got != "after" {
+		t.Errorf("registry title = %v, want unchanged by snapshot", got)
+	}
+}
+
+// TestRegistryConcurrency tests changing defaults while prompts are built (run with -race)
+func TestRegistryConcurrency(t *testing.T) {
+	layered := NewLayeredRegistry()
+	scope, _ := Override(layered, nil)
+	var wg sync.WaitGroup
+	for i := range 4 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := range 100 {
+				layered.SetDefault(KeyTitle, ptInput, fmt.Sprintf("title %v", j))
+				if err := layered.Set(LayerUser, "all", "width", j); err != nil {
+					t.Error(err)
+				}
+				scope.SetDefault(KeyPlaceholder, ptInput, fmt.Sprint(i))
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				pb, err := newPromptBuilder(ptInput, WithDefaults(scope))
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				_ = pb.getTitle() + pb.getPlaceholder()
+				_ = pb.getWidth()
+			}
+		}()
+	}
+	wg.Wait()
+}
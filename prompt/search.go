@@ -1,6 +1,7 @@
 package prompt
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -39,34 +40,31 @@ type searchModel struct {
 	summary     string // Summary text showing filtered results
 	cursor      cursor // Cursor management for selection
 
-	lg            *lipgloss.Renderer // Lipgloss renderer for styling
-	theme         *huh.Theme         // Visual theme for consistent styling
-	fullList      []*Item            // Complete unfiltered item list
-	filteredList  []*Item            // Currently filtered item list
-	selectedItem  *Item              // Currently selected item
-	width         int                // Prompt width
-	height        int                // Prompt height
-	caseSensitive bool               // Whether search is case sensitive
-	done          bool               // Whether search is completed
-	err           error              // Error state if search fails
+	lg            *lipgloss.Renderer      // Lipgloss renderer for styling
+	theme         *huh.Theme              // Visual theme for consistent styling
+	msgs          localizer               // Translator of built-in strings
+	fullList      []*Item                 // Complete unfiltered item list
+	filteredList  []*Item                 // Currently filtered item list
+	selectedItem  *Item                   // Currently selected item
+	width         int                     // Prompt width
+	height        int                     // Prompt height
+	caseSensitive bool                    // Whether search is case sensitive
+	descriptions  bool                    // Whether filter matches item descriptions
+	validator     ItemValidationFunc      // Validator of the chosen item
+	async         *asyncValidation[*Item] // Asynchronous validator of the highlighted item
+	warnings      warningState            // Validation warning of the chosen item
+	hooks         hooks                   // Lifecycle callbacks
+	done          bool                    // Whether search is completed
+	err           error                   // Error state if search fails
+	valErr        error                   // Validation error or submit veto shown under the list
+	id            int                     // ID sent with the result message
 }
 
-// newSearch creates and initializes a new search model with the provided options.
-// Sets up the search state with default values and applies configuration options.
+// newSearch creates and initializes a new search model from a configured prompt builder.
+// Sets up the search state with values taken from the builder.
 // Returns the search model or an error if initialization fails.
 // (ai generated comment)
-func newSearch(opts ...PromptOption) (*searchModel, error) {
-	pb := &promptBuilder{
-		promptType:       ptSearch,
-		settings:         map[any]any{},
-		defaultsRegistry: defaultRegistry(),
-	}
-	for _, modify := range opts {
-		modify(pb)
-	}
-	if err := validateRequiredFields(pb); err != nil {
-		return nil, fmt.Errorf("failed field validation: %v", err)
-	}
+func newSearch(pb *promptBuilder) (*searchModel, error) {
 	sm := searchModel{
 		filter:        "",
 		cursor:        defaultCursor,
@@ -75,26 +73,56 @@ func newSearch(opts ...PromptOption) (*searchModel, error) {
 		filteredList:  pb.getItems(),
 		title:         pb.getTitle(),
 		theme:         pb.getTheme(),
+		msgs:          pb.localizer(),
 		description:   pb.getDescription(),
 		body:          "",
 		summary:       "",
 		width:         pb.getWidth(),
 		height:        max(pb.getHeight(), 20),
 		caseSensitive: pb.getCaseSensitive(),
+		descriptions:  pb.getSearchDescriptions(),
+		validator:     pb.getItemValidator(),
+		warnings:      warningState{confirm: pb.getConfirmWarnings()},
+		hooks:         pb.hooks(),
+		id:            pb.id,
 	}
+	sm.async = newAsyncValidation(pb.getAsyncItemValidator(), pb.getValidationDebounce(), sm.theme).withHooks(sm.hooks).withMessages(sm.msgs)
 	if len(sm.fullList) == 0 {
 		return nil, fmt.Errorf("search-items pool is empty")
 	}
 	copy(sm.filteredList, sm.fullList)
+	if filter := pb.getDefaultValue(); filter != "" {
+		sm.filter = filter
+		sm.updateFilter()
+	}
+	if preselected := matchItems(sm.filteredList, pb.getSelected()); len(preselected) > 0 {
+		for i, item := range sm.filteredList {
+			if item == preselected[0] {
+				sm.moveCursor(i)
+				break
+			}
+		}
+	}
 	return &sm, nil
 }
 
 // Init initializes the search model as required by the Bubble Tea Model interface.
+// Starts asynchronous check of the highlighted item if configured.
 // (ai generated comment)
 func (sm searchModel) Init() tea.Cmd {
+	if item := sm.getSelectedItem(); item != nil && !item.disabled {
+		return sm.async.change(item)
+	}
 	return nil
 }
 
+// replayMode returns the model with checks without debounce for session replay.
+// (ai generated comment)
+func (sm searchModel) replayMode() tea.Model {
+	sm.async = sm.async.replayMode()
+	return sm
+}
+
 // updateFilter refreshes the filtered list based on the current search term.
 // If the filter is empty, shows all items. Otherwise, filters items that match the search.
 // (ai generated comment)
@@ -127,30 +155,54 @@ func normalizeFilter(filter string, caseSensitive bool) string {
 
 // matchSearch checks if an item matches the search term.
 // Handles case sensitivity and performs substring matching.
+// Item description is matched too when description search is enabled.
 // (ai generated comment)
 func (sm *searchModel) matchSearch(item *Item, searchTerm string) bool {
-	itemKey := item.key
-	if !sm.caseSensitive {
-		itemKey = strings.ToLower(itemKey)
+	fields := []string{item.key}
+	if sm.descriptions {
+		fields = append(fields, item.description)
 	}
-	return strings.Contains(itemKey, searchTerm)
+	for _, field := range fields {
+		if strings.Contains(normalizeFilter(field, sm.caseSensitive), searchTerm) {
+			return true
+		}
+	}
+	return false
 }
 
 // Update handles messages and updates the search model state.
 // Processes keyboard input for navigation, filtering, and selection.
+// With asynchronous validator selection waits until the check of the highlighted item passes.
 // Returns the updated model and any commands to execute.
 // (ai generated comment)
 func (sm searchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if sm.completed() {
+		return sm, nil
+	}
+	if handled, submit, cmd := sm.async.update(msg); handled {
+		if submit {
+			return sm.choose(sm.async.value)
+		}
+		return sm, cmd
+	}
 	var cmds []tea.Cmd
+	filter, highlighted := sm.filter, sm.getSelectedItem()
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		sm.valErr = nil
 		switch msg.String() {
 		case "ctrl+c":
-			return sm, tea.Interrupt
+			sm.async.stop()
+			sm.done = true
+			sm.err = tea.ErrInterrupted
+			sm.hooks.cancel(sm.err)
+			return sm, sm.resultCmd()
 		case "esc":
+			sm.async.stop()
 			sm.done = true
-			sm.err = fmt.Errorf("search canceled")
-			return sm, tea.Quit
+			sm.err = sm.msgs.wrap(ErrCanceled, "search canceled")
+			sm.hooks.cancel(sm.err)
+			return sm, sm.resultCmd()
 		case "backspace":
 			switch glyphsLen(sm.filter) {
 			case 0:
@@ -169,11 +221,18 @@ func (sm searchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "pgup":
 			sm.moveCursor(sm.maxListHeight() * -1)
 		case "enter":
-			sm.selectedItem = sm.getSelectedItem()
-			if sm.selectedItem == nil {
-				sm.err = fmt.Errorf("no item selected")
+			item := sm.getSelectedItem()
+			if item == nil {
+				sm.err = errors.New(sm.msgs.t("no item selected"))
+				return sm, sm.resultCmd()
+			}
+			if item.disabled || !sm.validate(item) {
+				return sm, nil
+			}
+			if ready, cmd := sm.async.requestSubmit(item); !ready {
+				return sm, cmd
 			}
-			cmds = append(cmds, tea.Quit)
+			return sm.choose(item)
 		default:
 			if glyphsLen(msg.String()) == 1 {
 				sm.filter += msg.String()
@@ -182,14 +241,60 @@ func (sm searchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			sm.cursorReset()
 		}
 	}
-	if len(sm.filteredList) == 1 {
-		sm.selectedItem = sm.filteredList[0]
-		cmds = append(cmds, tea.Quit)
+	if sm.filter != filter {
+		sm.hooks.change(sm.filter)
+	}
+	if item := sm.getSelectedItem(); item != nil && item != highlighted {
+		sm.hooks.change(item)
+	}
+	if len(sm.filteredList) == 1 && !sm.filteredList[0].disabled && sm.valErr == nil && sm.validate(sm.filteredList[0]) {
+		ready, cmd := sm.async.requestSubmit(sm.filteredList[0])
+		if !ready {
+			return sm, cmd
+		}
+		return sm.choose(sm.filteredList[0])
+	} else if item := sm.getSelectedItem(); item != nil && !item.disabled {
+		cmds = append(cmds, sm.async.change(item))
 	}
 
 	return sm, tea.Batch(cmds...)
 }
 
+// validate checks the item with the validator before submit. Blocking errors are shown
+// under the list; warnings may need a second submit (see WithWarningConfirmation).
+// Returns whether submit may proceed.
+// (ai generated comment)
+func (sm *searchModel) validate(item *Item) bool {
+	proceed, err := sm.warnings.check(sm.validator(item))
+	if err != nil {
+		sm.valErr = sm.hooks.invalid(item, err)
+	}
+	return proceed
+}
+
+// choose completes the search with the item unless OnSubmit callback vetoes it.
+// (ai generated comment)
+func (sm searchModel) choose(item *Item) (tea.Model, tea.Cmd) {
+	if err := sm.hooks.submit(item); err != nil {
+		sm.valErr = err
+		return sm, nil
+	}
+	sm.selectedItem = item
+	return sm, sm.resultCmd()
+}
+
+// completed reports whether the search has ended with a chosen item or an error.
+// (ai generated comment)
+func (sm searchModel) completed() bool {
+	return sm.done || sm.err != nil || sm.selectedItem != nil
+}
+
+// resultCmd sends the chosen item or the error as result of the prompt.
+// (ai generated comment)
+func (sm searchModel) resultCmd() tea.Cmd {
+	return sendResult(sm.id, sm.selectedItem, sm.err)
+}
+
 // cursorReset resets the cursor to the top of the filtered list.
 // Called when the filter changes to ensure selection starts from the beginning.
 // (ai generated comment)
@@ -209,13 +314,11 @@ func (sm *searchModel) moveCursor(direction int) {
 		case false:
 			sm.cursor.index = max(sm.cursor.offset+direction, sm.cursor.index+direction, 0)
 		}
-		if sm.cursor.index >= sm.maxCursorIndexAllowed() {
-			sm.cursor.index = sm.maxCursorIndexAllowed()
-			sm.cursor.offset = sm.cursor.index - sm.maxListHeight() + direction
-		}
-		if sm.cursor.index < sm.cursor.offset {
-			sm.cursor.offset = sm.cursor.index
+		if end := sm.maxCursorIndexAllowed(); sm.cursor.index >= end {
+			sm.cursor.index = end
+			sm.cursor.offset = min(sm.cursor.offset+direction, end)
 		}
+		sm.cursor.offset = scrollTo(sm.filteredList, sm.cursor.offset, sm.cursor.index, sm.maxListHeight())
 	}
 }
 
@@ -238,10 +341,10 @@ func (sm *searchModel) getSelectedItem() *Item {
 }
 
 // startLine returns the styled string that begins each line in the view.
-// Uses box-drawing characters for consistent visual structure.
+// The box-drawing bar uses the theme's prompt style, matching the bar before the title.
 // (ai generated comment)
-func startLine() string {
-	return lipgloss.NewStyle().Render("\n┃ ")
+func startLine(theme *huh.Theme) string {
+	return "\n" + theme.Focused.TextInput.Prompt.Render("┃ ")
 }
 
 // viewTitle renders the title section of the search prompt.
@@ -261,20 +364,21 @@ func (sm *searchModel) viewDescription() string {
 	if sm.description == "" {
 		return ""
 	}
-	return startLine() + sm.theme.Focused.Description.Render(sm.description)
+	return startLine(sm.theme) + sm.theme.Focused.Description.Render(sm.description)
 }
 
 // viewFilter renders the current filter input section.
 // Shows the filter label and the current filter text.
 // (ai generated comment)
 func (sm *searchModel) viewFilter() string {
-	s := startLine() + "filter: " + sm.theme.Focused.TextInput.Prompt.Render(sm.filter)
-	s += startLine()
+	s := startLine(sm.theme) + sm.theme.Focused.Description.Render(sm.msgs.t("filter: ")) + sm.theme.Focused.TextInput.Text.Render(sm.filter)
+	s += startLine(sm.theme)
 	return s
 }
 
 // viewBody renders the main list body with filtered items.
 // Only displays items within the current scroll viewport.
+// Group headers, hints and descriptions are rendered around item keys.
 // (ai generated comment)
 func (sm *searchModel) viewBody() string {
 	start := sm.cursor.offset
@@ -282,7 +386,9 @@ func (sm *searchModel) viewBody() string {
 	s := ""
 	for i := start; i < end; i++ {
 		item := sm.filteredList[i]
-		s += startLine() + sm.renderCursor(i) + sm.renderItem(item)
+		s += renderGroupHeader(sm.theme, sm.filteredList, i, start)
+		s += startLine(sm.theme) + sm.renderCursor(i) + sm.renderItem(item) + renderItemSuffix(sm.theme, sm.msgs, item)
+		s += renderItemDescription(sm.theme, item, sm.cursor.unselected)
 	}
 	return s
 }
@@ -300,31 +406,34 @@ func (sm *searchModel) renderCursor(index int) string {
 
 // renderItem renders an individual item with search term highlighting.
 // Highlights the matching portion of the item key using theme colors.
+// Item style overrides and disabled state apply to the rest of the key.
 // (ai generated comment)
 func (sm *searchModel) renderItem(item *Item) string {
 	/*
 		ai generated func
 	*/
-	s := item.key
+	style := itemKeyStyle(sm.theme, item, sm.theme.Focused.UnselectedOption)
+	s := style.Render(item.key)
 	lowerItem := strings.ToLower(item.key)
 	lowerInput := strings.ToLower(sm.filter)
-	if idx := strings.Index(lowerItem, lowerInput); idx != -1 {
+	if idx := strings.Index(lowerItem, lowerInput); idx != -1 && sm.filter != "" {
 		before := item.key[:idx]
 		match := item.key[idx : idx+len(sm.filter)]
 		after := item.key[idx+len(sm.filter):]
-		s = before + sm.theme.Focused.SelectedOption.Render(match) + after
+		s = style.Render(before) + sm.theme.Focused.SelectedOption.Render(match) + style.Render(after)
 	}
 	return s
 }
 
-// maxCursorIndexAllowed calculates the maximum cursor index for the current view.
+// maxCursorIndexAllowed calculates the index after the last item visible in the current view.
 // Ensures cursor doesn't go beyond the visible portion of the filtered list.
 // (ai generated comment)
 func (sm *searchModel) maxCursorIndexAllowed() int {
-	return min(sm.cursor.offset+sm.maxListHeight(), len(sm.filteredList))
+	return visibleEnd(sm.filteredList, sm.cursor.offset, sm.maxListHeight())
 }
 
-// maxListHeight calculates the maximum available height for displaying items.
+// maxListHeight calculates the maximum number of lines available for displaying items,
+// including group headers and descriptions.
 // (ai generated comment)
 func (sm *searchModel) maxListHeight() int {
 	n := 0
@@ -338,16 +447,25 @@ func (sm *searchModel) maxListHeight() int {
 	return max(sm.height-n-2, 0)
 }
 
+// viewError renders the message of the vetoed submit.
+// (ai generated comment)
+func (sm *searchModel) viewError() string {
+	if sm.valErr == nil {
+		return ""
+	}
+	return startLine(sm.theme) + sm.theme.Focused.ErrorMessage.Render(sm.valErr.Error())
+}
+
 // viewSummary renders the summary section showing filtering statistics.
 // Displays counts of filtered items and current viewport range.
 // (ai generated comment)
 func (sm *searchModel) viewSummary() string {
 	s := ""
 	if len(sm.filteredList) != 0 || len(sm.filteredList) != len(sm.fullList) {
-		s += startLine() + startLine() + sm.theme.Focused.Option.Render(fmt.Sprintf("%v/%v items filtered", len(sm.filteredList), len(sm.fullList)))
+		s += startLine(sm.theme) + startLine(sm.theme) + sm.theme.Focused.Option.Render(sm.msgs.plural("%v/%v item filtered|%v/%v items filtered", len(sm.fullList), len(sm.filteredList), len(sm.fullList)))
 	}
 	if sm.maxCursorIndexAllowed()-sm.cursor.offset != len(sm.filteredList) {
-		s += "\n" + sm.theme.Help.ShortKey.Render(fmt.Sprintf("show items [%v-%v] of %v filtered", sm.cursor.offset, sm.maxCursorIndexAllowed(), len(sm.filteredList)))
+		s += startLine(sm.theme) + sm.theme.Help.ShortKey.Render(sm.msgs.t("show items [%v-%v] of %v filtered", sm.cursor.offset, sm.maxCursorIndexAllowed(), len(sm.filteredList)))
 
 	}
 
@@ -358,7 +476,7 @@ func (sm *searchModel) viewSummary() string {
 // Shows available keyboard shortcuts for navigation and actions.
 // (ai generated comment)
 func (sm *searchModel) viewHelp() string {
-	return renderHelp(sm.theme, []key.Binding{
+	return renderHelp(sm.theme, sm.msgs, []key.Binding{
 		key.NewBinding(key.WithHelp("↑/↓", "move cursor")),
 		key.NewBinding(key.WithHelp("enter", "submit")),
 		key.NewBinding(key.WithHelp("esc", "cancel")),
@@ -379,18 +497,22 @@ func (sm searchModel) View() string {
 	s += sm.viewDescription()
 	s += sm.viewFilter()
 	s += sm.viewBody()
+	s += sm.async.view()
+	s += sm.warnings.view(sm.theme, sm.msgs)
+	s += sm.viewError()
 	s += sm.viewSummary()
 	s += sm.viewHelp()
 	return s
 }
 
 // renderHelp generates a formatted help section with key bindings.
-// Uses the provided theme for consistent styling of keys and descriptions.
+// Uses the provided theme for consistent styling of keys and descriptions
+// translated with the localizer.
 // (ai generated comment)
-func renderHelp(theme *huh.Theme, binds []key.Binding) string {
+func renderHelp(theme *huh.Theme, msgs localizer, binds []key.Binding) string {
 	s := "\n\n"
 	for i, bind := range binds {
-		s += theme.Help.FullKey.Render(bind.Help().Key) + " " + theme.Help.FullDesc.Render(bind.Help().Desc)
+		s += theme.Help.FullKey.Render(msgs.t(bind.Help().Key)) + " " + theme.Help.FullDesc.Render(msgs.t(bind.Help().Desc))
 		if i != len(binds)-1 {
 			s += theme.Help.FullSeparator.Render(" • ")
 		}
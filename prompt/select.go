@@ -0,0 +1,487 @@
+package prompt
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+)
+
+// defaultSelectHeight is the number of visible items used when prompt height is not set.
+// (ai generated comment)
+const defaultSelectHeight = 10
+
+// selectModel represents the Bubble Tea model for selection prompts with item metadata.
+// It renders group headers, hints, descriptions and disabled items which huh selects can not show.
+// In multi mode space toggles items and enter submits all chosen items.
+// (ai generated comment)
+type selectModel struct {
+	title       string // Main title displayed at the top
+	description string // Additional description text
+
+	items         []*Item                 // Selectable items
+	multi         bool                    // Whether several items can be chosen
+	cursor        cursor                  // Cursor management for selection
+	chosen        map[*Item]bool          // Items chosen in multi mode
+	itemValidator ItemValidationFunc      // Validator for the selected item
+	listValidator ItemListValidationFunc  // Validator for chosen items in multi mode
+	async         *asyncValidation[*Item] // Asynchronous validator of the highlighted item
+	hooks         hooks                   // Lifecycle callbacks
+	warnings      warningState            // Validation warning of the current choice
+	selected      []*Item                 // Submitted items
+
+	theme  *huh.Theme // Visual theme for consistent styling
+	msgs   localizer  // Translator of built-in strings
+	height int        // Prompt height
+	valErr error      // Validation error shown under the list
+	done   bool       // Whether selection is completed
+	err    error      // Error state if selection fails
+	id     int        // ID sent with the result message
+}
+
+// checkItemPool returns an error if the pool is empty, has bad items or,
+// in single selection, has no enabled items.
+// (ai generated comment)
+func checkItemPool(items []*Item, multi bool) error {
+	if len(items) == 0 {
+		return fmt.Errorf("item pool is empty")
+	}
+	enabled := 0
+	for i, item := range items {
+		if err := defaultItemValidationFunc(item); err != nil {
+			return fmt.Errorf("bad item list: item %v: %v", i, err)
+		}
+		if !item.disabled {
+			enabled++
+		}
+	}
+	if enabled == 0 && !multi {
+		return fmt.Errorf("all items are disabled")
+	}
+	return nil
+}
+
+// newSelectModel creates and initializes a selection model from a configured prompt builder.
+// Returns the model or an error if the item pool is empty.
+// (ai generated comment)
+func newSelectModel(pb *promptBuilder) (*selectModel, error) {
+	sm := selectModel{
+		title:       pb.getTitle(),
+		description: pb.getDescription(),
+		items:       pb.getItems(),
+		multi:       pb.promptType == ptSelectMulti,
+		cursor:      defaultCursor,
+		chosen:      make(map[*Item]bool),
+		theme:       pb.getTheme(),
+		msgs:        pb.localizer(),
+		height:      pb.getHeight(),
+		warnings:    warningState{confirm: pb.getConfirmWarnings()},
+		hooks:       pb.hooks(),
+		id:          pb.id,
+	}
+	switch sm.multi {
+	case true:
+		sm.listValidator = pb.getItemListValidator()
+	case false:
+		sm.itemValidator = pb.getItemValidator()
+		sm.async = newAsyncValidation(pb.getAsyncItemValidator(), pb.getValidationDebounce(), sm.theme).withHooks(sm.hooks).withMessages(sm.msgs)
+	}
+	if err := checkItemPool(sm.items, sm.multi); err != nil {
+		return nil, err
+	}
+	sm.preselect(matchItems(sm.items, pb.getSelected()))
+	sm.showWarnings()
+	return &sm, nil
+}
+
+// preselect chooses given items in multi mode and moves the cursor to the first of them.
+// (ai generated comment)
+func (sm *selectModel) preselect(items []*Item) {
+	for _, item := range items {
+		if sm.multi {
+			sm.chosen[item] = true
+		}
+	}
+	if len(items) == 0 {
+		return
+	}
+	for i, item := range sm.items {
+		if item == items[0] {
+			sm.moveCursor(i)
+			return
+		}
+	}
+}
+
+// Init initializes the selection model as required by the Bubble Tea Model interface.
+// Starts asynchronous check of the highlighted item if configured.
+// (ai generated comment)
+func (sm selectModel) Init() tea.Cmd {
+	return sm.checkHighlighted()
+}
+
+// replayMode returns the model with checks without debounce for session replay.
+// (ai generated comment)
+func (sm selectModel) replayMode() tea.Model {
+	sm.async = sm.async.replayMode()
+	return sm
+}
+
+// checkHighlighted schedules asynchronous check of the highlighted enabled item.
+// (ai generated comment)
+func (sm *selectModel) checkHighlighted() tea.Cmd {
+	item := sm.items[sm.cursor.index]
+	if item.disabled {
+		return nil
+	}
+	return sm.async.change(item)
+}
+
+// Update handles messages and updates the selection model state.
+// Disabled items can be focused to read their reason but can not be chosen.
+// With asynchronous validator submit waits until the check of the highlighted item passes.
+// (ai generated comment)
+func (sm selectModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if sm.done {
+		return sm, nil
+	}
+	if handled, submit, cmd := sm.async.update(msg); handled {
+		if submit {
+			return sm.finish([]*Item{sm.async.value})
+		}
+		return sm, cmd
+	}
+	msgKey, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return sm, nil
+	}
+	switch msgKey.String() {
+	case "ctrl+c":
+		sm.async.stop()
+		sm.done = true
+		sm.err = tea.ErrInterrupted
+		sm.hooks.cancel(sm.err)
+		return sm, sm.resultCmd()
+	case "esc":
+		sm.async.stop()
+		sm.done = true
+		sm.err = sm.msgs.wrap(ErrCanceled, "selection canceled")
+		sm.hooks.cancel(sm.err)
+		return sm, sm.resultCmd()
+	case "up", "k":
+		sm.moveCursor(-1)
+	case "down", "j":
+		sm.moveCursor(1)
+	case "pgup":
+		sm.moveCursor(-sm.listHeight())
+	case "pgdown":
+		sm.moveCursor(sm.listHeight())
+	case "home", "g":
+		sm.moveCursor(-len(sm.items))
+	case "end", "G":
+		sm.moveCursor(len(sm.items))
+	case " ", "x":
+		if sm.multi {
+			sm.toggle(sm.items[sm.cursor.index])
+		}
+	case "ctrl+a":
+		if sm.multi {
+			sm.toggleAll()
+		}
+	case "enter":
+		return sm.submit()
+	}
+	sm.valErr = nil
+	sm.showWarnings()
+	return sm, sm.checkHighlighted()
+}
+
+// showWarnings updates the warning of the highlighted item or chosen items.
+// (ai generated comment)
+func (sm *selectModel) showWarnings() {
+	if sm.multi {
+		sm.warnings.show(sm.listValidator(sm.chosenItems()))
+		return
+	}
+	sm.warnings.show(sm.itemValidator(sm.items[sm.cursor.index]))
+}
+
+// chosenItems returns items chosen in multi mode in list order.
+// (ai generated comment)
+func (sm *selectModel) chosenItems() []*Item {
+	chosen := []*Item{}
+	for _, item := range sm.items {
+		if sm.chosen[item] {
+			chosen = append(chosen, item)
+		}
+	}
+	return chosen
+}
+
+// moveCursor moves the cursor by delta items and scrolls the viewport to keep it visible.
+// (ai generated comment)
+func (sm *selectModel) moveCursor(delta int) {
+	before := sm.cursor.index
+	sm.cursor.index = min(max(sm.cursor.index+delta, 0), len(sm.items)-1)
+	if sm.cursor.index != before && !sm.multi {
+		sm.hooks.change(sm.items[sm.cursor.index])
+	}
+	sm.cursor.offset = scrollTo(sm.items, sm.cursor.offset, sm.cursor.index, sm.listHeight())
+}
+
+// toggle switches the chosen state of an enabled item.
+// (ai generated comment)
+func (sm *selectModel) toggle(item *Item) {
+	if item.disabled {
+		return
+	}
+	sm.chosen[item] = !sm.chosen[item]
+	sm.valErr = nil
+	sm.hooks.change(sm.chosenItems())
+}
+
+// toggleAll chooses all enabled items, or clears the choice if all are already chosen.
+// (ai generated comment)
+func (sm *selectModel) toggleAll() {
+	all := true
+	for _, item := range sm.items {
+		if !item.disabled && !sm.chosen[item] {
+			all = false
+		}
+	}
+	for _, item := range sm.items {
+		if !item.disabled {
+			sm.chosen[item] = !all
+		}
+	}
+	sm.valErr = nil
+	sm.hooks.change(sm.chosenItems())
+}
+
+// submit validates the current choice and completes the selection if it is valid.
+// (ai generated comment)
+func (sm selectModel) submit() (tea.Model, tea.Cmd) {
+	if sm.multi {
+		chosen := sm.chosenItems()
+		proceed, err := sm.warnings.check(sm.listValidator(chosen))
+		if err != nil {
+			sm.valErr = sm.hooks.invalid(chosen, err)
+		}
+		if !proceed {
+			return sm, nil
+		}
+		return sm.finish(chosen)
+	}
+	item := sm.items[sm.cursor.index]
+	if item.disabled {
+		return sm, nil
+	}
+	proceed, err := sm.warnings.check(sm.itemValidator(item))
+	if err != nil {
+		sm.valErr = sm.hooks.invalid(item, err)
+	}
+	if !proceed {
+		return sm, nil
+	}
+	if ready, cmd := sm.async.requestSubmit(item); !ready {
+		return sm, cmd
+	}
+	return sm.finish([]*Item{item})
+}
+
+// finish completes the selection with the items.
+// OnSubmit callback can veto it, leaving the list open with the veto message.
+// (ai generated comment)
+func (sm selectModel) finish(items []*Item) (tea.Model, tea.Cmd) {
+	sm.selected = items
+	if err := sm.hooks.submit(sm.resultValue()); err != nil {
+		sm.selected = nil
+		sm.valErr = err
+		return sm, nil
+	}
+	sm.done = true
+	return sm, sm.resultCmd()
+}
+
+// resultCmd sends the chosen item (single mode), chosen items (multi mode) or the error
+// as result of the prompt.
+// (ai generated comment)
+func (sm selectModel) resultCmd() tea.Cmd {
+	return sendResult(sm.id, sm.resultValue(), sm.err)
+}
+
+// resultValue returns the chosen item in single mode or chosen items in multi mode.
+// (ai generated comment)
+func (sm selectModel) resultValue() any {
+	if !sm.multi && len(sm.selected) > 0 {
+		return sm.selected[0]
+	}
+	return sm.selected
+}
+
+// listHeight returns the number of list lines visible at once.
+// Group headers and descriptions take lines of their own.
+// (ai generated comment)
+func (sm *selectModel) listHeight() int {
+	if sm.height <= 0 {
+		return defaultSelectHeight
+	}
+	return max(sm.height-4, 3)
+}
+
+// viewTitle renders the title section of the selection prompt.
+// (ai generated comment)
+func (sm *selectModel) viewTitle() string {
+	if sm.title == "" {
+		return ""
+	}
+	return sm.theme.Focused.TextInput.Prompt.Render("┃ ") + sm.theme.Focused.Title.Render(sm.title)
+}
+
+// viewDescription renders the description section of the selection prompt.
+// (ai generated comment)
+func (sm *selectModel) viewDescription() string {
+	if sm.description == "" {
+		return ""
+	}
+	return startLine(sm.theme) + sm.theme.Focused.Description.Render(sm.description)
+}
+
+// visibleEnd returns the index after the last item fitting into the list height.
+// (ai generated comment)
+func (sm *selectModel) visibleEnd() int {
+	return visibleEnd(sm.items, sm.cursor.offset, sm.listHeight())
+}
+
+// viewBody renders visible items with group headers, hints and descriptions.
+// (ai generated comment)
+func (sm *selectModel) viewBody() string {
+	start := sm.cursor.offset
+	end := sm.visibleEnd()
+	s := ""
+	for i := start; i < end; i++ {
+		item := sm.items[i]
+		s += renderGroupHeader(sm.theme, sm.items, i, start)
+		s += startLine(sm.theme) + sm.renderCursor(i) + sm.renderPrefix(item) + sm.renderItem(i)
+		s += renderItemSuffix(sm.theme, sm.msgs, item)
+		s += renderItemDescription(sm.theme, item, sm.cursor.unselected+sm.renderPrefixPadding())
+	}
+	return s
+}
+
+// renderCursor renders the cursor symbol for a given index.
+// (ai generated comment)
+func (sm *selectModel) renderCursor(index int) string {
+	if index == sm.cursor.index {
+		return sm.theme.Focused.SelectSelector.Render(sm.cursor.selected)
+	}
+	return sm.cursor.unselected
+}
+
+// renderPrefix renders the chosen state checkbox in multi mode.
+// (ai generated comment)
+func (sm *selectModel) renderPrefix(item *Item) string {
+	if !sm.multi {
+		return ""
+	}
+	if sm.chosen[item] {
+		return sm.theme.Focused.SelectedPrefix.Render("[•] ")
+	}
+	return sm.theme.Focused.UnselectedPrefix.Render("[ ] ")
+}
+
+// renderPrefixPadding returns the blank space matching the checkbox width.
+// (ai generated comment)
+func (sm *selectModel) renderPrefixPadding() string {
+	if !sm.multi {
+		return ""
+	}
+	return "    "
+}
+
+// renderItem renders the key of the item at the given index.
+// (ai generated comment)
+func (sm *selectModel) renderItem(index int) string {
+	item := sm.items[index]
+	base := sm.theme.Focused.UnselectedOption
+	switch {
+	case sm.chosen[item], !sm.multi && index == sm.cursor.index:
+		base = sm.theme.Focused.SelectedOption
+	}
+	return itemKeyStyle(sm.theme, item, base).Render(item.key)
+}
+
+// viewError renders the validation error if present.
+// (ai generated comment)
+func (sm *selectModel) viewError() string {
+	if sm.valErr == nil {
+		return ""
+	}
+	return startLine(sm.theme) + sm.theme.Focused.ErrorMessage.Render(sm.valErr.Error())
+}
+
+// viewSummary renders the viewport position for long lists.
+// (ai generated comment)
+func (sm *selectModel) viewSummary() string {
+	end := sm.visibleEnd()
+	if sm.cursor.offset == 0 && end == len(sm.items) {
+		return ""
+	}
+	return "\n" + sm.theme.Help.ShortKey.Render(sm.msgs.t("show items [%v-%v] of %v", sm.cursor.offset, end, len(sm.items)))
+}
+
+// viewHelp renders the help section with key binding instructions.
+// (ai generated comment)
+func (sm *selectModel) viewHelp() string {
+	binds := []key.Binding{
+		key.NewBinding(key.WithHelp("↑/↓", "move cursor")),
+	}
+	if sm.multi {
+		binds = append(binds,
+			key.NewBinding(key.WithHelp("space", "toggle")),
+			key.NewBinding(key.WithHelp("ctrl+a", "toggle all")),
+		)
+	}
+	binds = append(binds,
+		key.NewBinding(key.WithHelp("enter", "submit")),
+		key.NewBinding(key.WithHelp("esc", "cancel")),
+	)
+	return renderHelp(sm.theme, sm.msgs, binds)
+}
+
+// View renders the complete selection prompt interface.
+// Returns empty string if the selection is completed.
+// (ai generated comment)
+func (sm selectModel) View() string {
+	if sm.done {
+		return ""
+	}
+	s := sm.viewTitle()
+	s += sm.viewDescription()
+	s += startLine(sm.theme)
+	s += sm.viewBody()
+	s += sm.viewError()
+	s += sm.warnings.view(sm.theme, sm.msgs)
+	s += sm.async.view()
+	s += sm.viewSummary()
+	s += sm.viewHelp()
+	return s
+}
+
+// runSelectModel runs the selection model and returns the submitted items.
+// (ai generated comment)
+func runSelectModel(pb *promptBuilder) ([]*Item, error) {
+	sm, err := newSelectModel(pb)
+	if err != nil {
+		return nil, err
+	}
+	resultState, err := runModel(pb, sm)
+	if err != nil {
+		return nil, err
+	}
+	if finalModel, ok := resultState.(selectModel); ok {
+		return finalModel.selected, finalModel.err
+	}
+	return nil, fmt.Errorf("unexpected endpoint reached")
+}
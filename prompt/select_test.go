@@ -0,0 +1,222 @@
+package prompt
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// newTestSelectModel creates a selection model with metadata-rich items
+func newTestSelectModel(t *testing.T, pt promptType, opts ...PromptOption) selectModel {
+	t.Helper()
+	items := []*Item{
+		NewItem("apple").WithGroup("fruit").WithHint("new"),
+		NewItem("pear").WithGroup("fruit").WithDisabled("out of stock"),
+		NewItem("carrot").WithGroup("vegetables").WithDescription("orange root"),
+	}
+	sm, err := newSelectModel(newTestBuilder(t, pt, append(opts, FromItems(items))...))
+	if err != nil {
+		t.Fatalf("newSelectModel() error = %v", err)
+	}
+	return *sm
+}
+
+// TestSelectModelSingle tests that disabled items are skipped on submit
+func TestSelectModelSingle(t *testing.T) {
+	var m tea.Model = newTestSelectModel(t, ptSelect)
+	m = pressKeys(m, tea.KeyDown, tea.KeyEnter)
+	if sm := m.(selectModel); sm.done {
+		t.Fatal("disabled item should not be submitted")
+	}
+	m = pressKeys(m, tea.KeyDown, tea.KeyEnter)
+	sm := m.(selectModel)
+	if !sm.done || len(sm.selected) != 1 || sm.selected[0].Key() != "carrot" {
+		t.Errorf("selected = %v, want carrot", sm.selected)
+	}
+}
+
+// TestSelectModelMulti tests toggling, disabled items and list validation
+func TestSelectModelMulti(t *testing.T) {
+	atLeastTwo := func(items []*Item) error {
+		if len(items) < 2 {
+			return errors.New("choose at least two")
+		}
+		return nil
+	}
+	var m tea.Model = newTestSelectModel(t, ptSelectMulti, WithItemListValidator(atLeastTwo))
+	m = pressKeys(m, tea.KeySpace, tea.KeyDown, tea.KeySpace, tea.KeyEnter)
+	sm := m.(selectModel)
+	if sm.done || sm.valErr == nil {
+		t.Fatal("expected validation error with one chosen item")
+	}
+	m = pressKeys(m, tea.KeyCtrlA, tea.KeyEnter)
+	sm = m.(selectModel)
+	if !sm.done {
+		t.Fatalf("expected submit, got valErr = %v", sm.valErr)
+	}
+	keys := []string{}
+	for _, item := range sm.selected {
+		keys = append(keys, item.Key())
+	}
+	if strings.Join(keys, ",") != "apple,carrot" {
+		t.Errorf("selected = %v, want apple,carrot", keys)
+	}
+}
+
+// TestSelectModelAllDisabled tests that single selection needs an enabled item
+func TestSelectModelAllDisabled(t *testing.T) {
+	pb := newTestBuilder(t, ptSelect, FromItems([]*Item{NewItem("a").WithDisabled("")}))
+	if _, err := newSelectModel(pb); err == nil {
+		t.Error("expected error when all items are disabled")
+	}
+}
+
+// TestSelectSingleNilItem tests that a single nil item is rejected instead of returned
+func TestSelectSingleNilItem(t *testing.T) {
+	if item, err := SelectSingle(FromItems([]*Item{nil})); err == nil {
+		t.Errorf("SelectSingle() = %v, want error for nil item", item)
+	}
+}
+
+// describedItems creates items with two line descriptions in groups of three
+func describedItems(n int) []*Item {
+	items := []*Item{}
+	for i := range n {
+		items = append(items, NewItem(fmt.Sprintf("item %v", i)).WithGroup(fmt.Sprintf("group %v", i/3)).WithDescription("first line\nsecond line"))
+	}
+	return items
+}
+
+// TestListHeightLines tests that headers and descriptions count against the list height while scrolling
+func TestListHeightLines(t *testing.T) {
+	pb := newTestBuilder(t, ptSelect, FromItems(describedItems(10)), WithHeight(14))
+	sm, err := newSelectModel(pb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	search, err := newSearch(newTestBuilder(t, ptSearch, FromItems(describedItems(10)), WithHeight(24)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	models := []struct {
+		model  tea.Model
+		height int
+		body   func(tea.Model) string
+	}{
+		{*sm, sm.listHeight(), func(m tea.Model) string { sm := m.(selectModel); return sm.viewBody() }},
+		{*search, search.maxListHeight(), func(m tea.Model) string { sm := m.(searchModel); return sm.viewBody() }},
+	}
+	for _, tt := range models {
+		m := tt.model
+		for i := range 12 {
+			body := tt.body(m)
+			if lines := strings.Count(body, "\n"); lines > tt.height {
+				t.Fatalf("%T body after %v moves has %v lines, want at most %v:\n%v", m, i, lines, tt.height, body)
+			}
+			if !strings.Contains(body, "item "+fmt.Sprint(min(i, 9))) {
+				t.Fatalf("%T body after %v moves should show the cursor item:\n%v", m, i, body)
+			}
+			m = pressKeys(m, tea.KeyDown)
+		}
+	}
+}
+
+// TestSelectModelView tests rendering of item metadata
+func TestSelectModelView(t *testing.T) {
+	view := newTestSelectModel(t, ptSelect).View()
+	for _, want := range []string{"fruit", "vegetables", "[new]", "(out of stock)", "orange root"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View() does not contain %q", want)
+		}
+	}
+	if strings.Count(view, "fruit") != 1 {
+		t.Error("group header should be rendered once per group")
+	}
+}
+
+// TestSelectModelCancel tests that esc cancels selection
+func TestSelectModelCancel(t *testing.T) {
+	m := pressKeys(newTestSelectModel(t, ptSelect), tea.KeyEsc)
+	if err := m.(selectModel).err; !IsCanceled(err) {
+		t.Errorf("err = %v, want canceled", err)
+	}
+}
+
+// TestSearchDescriptions tests matching filter against item descriptions
+func TestSearchDescriptions(t *testing.T) {
+	items := []*Item{NewItem("carrot").WithDescription("orange root"), NewItem("apple")}
+	for _, enabled := range []bool{false, true} {
+		sm, err := newSearch(newTestBuilder(t, ptSearch, FromItems(items), WithDescriptionSearch(enabled)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		sm.filter = "root"
+		sm.updateFilter()
+		if got := len(sm.filteredList) == 1; got != enabled {
+			t.Errorf("description search %v: filtered %v items", enabled, len(sm.filteredList))
+		}
+	}
+}
+
+// TestSearchDisabledItem tests that disabled items are not selected
+func TestSearchDisabledItem(t *testing.T) {
+	items := []*Item{NewItem("carrot").WithDisabled("no"), NewItem("apple")}
+	sm, err := newSearch(newTestBuilder(t, ptSearch, FromItems(items)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := typeText(*sm, "car")
+	if m.(searchModel).selectedItem != nil {
+		t.Error("single disabled match should not be auto-selected")
+	}
+	m = pressKeys(m, tea.KeyEnter)
+	if m.(searchModel).selectedItem != nil {
+		t.Error("disabled item should not be selected with enter")
+	}
+}
+
+// TestSearchItemValidator tests that the item validator blocks enter and auto-submit of the search
+func TestSearchItemValidator(t *testing.T) {
+	noCarrot := func(item *Item) error {
+		if item.Key() == "carrot" {
+			return errors.New("carrot is taken")
+		}
+		return nil
+	}
+	items := []*Item{NewItem("carrot"), NewItem("apple")}
+	sm, err := newSearch(newTestBuilder(t, ptSearch, FromItems(items), WithItemValidator(noCarrot)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := pressKeys(*sm, tea.KeyEnter)
+	if m.(searchModel).selectedItem != nil {
+		t.Error("invalid item should not be selected with enter")
+	}
+	if !strings.Contains(m.View(), "carrot is taken") {
+		t.Errorf("view should show validation error:\n%v", m.View())
+	}
+	sm, _ = newSearch(newTestBuilder(t, ptSearch, FromItems(items), WithItemValidator(noCarrot)))
+	m = typeText(*sm, "car")
+	if m.(searchModel).selectedItem != nil {
+		t.Error("single invalid match should not be auto-selected")
+	}
+	m = typeText(*sm, "app")
+	if got := m.(searchModel).selectedItem; got == nil || got.Key() != "apple" {
+		t.Errorf("selected = %v, want auto-selected apple", got)
+	}
+}
+
+// TestSelectModelPreselected tests preselection by key, payload and item
+func TestSelectModelPreselected(t *testing.T) {
+	sm := newTestSelectModel(t, ptSelectMulti, WithSelected("carrot", "pear", "apple"))
+	if !sm.chosen[sm.items[0]] || sm.chosen[sm.items[1]] || !sm.chosen[sm.items[2]] {
+		t.Errorf("chosen = %v, want apple and carrot (pear is disabled)", sm.chosen)
+	}
+	sm = newTestSelectModel(t, ptSelect, WithSelected("carrot"))
+	if sm.cursor.index != 2 {
+		t.Errorf("cursor = %v, want 2", sm.cursor.index)
+	}
+}
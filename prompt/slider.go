@@ -0,0 +1,359 @@
+package prompt
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+)
+
+// defaultSliderWidth is the bar width used when prompt width is not set.
+// (ai generated comment)
+const defaultSliderWidth = 40
+
+// sliderModel represents the Bubble Tea model for slider and range slider prompts.
+// In range mode two handles select low and high values; tab switches the active handle.
+// (ai generated comment)
+type sliderModel struct {
+	title       string // Main title displayed at the top
+	description string // Additional description text
+
+	min        int            // Lowest selectable value
+	max        int            // Highest selectable value
+	step       int            // Fine step for left/right keys
+	coarseStep int            // Coarse step for paging keys
+	ticks      map[int]string // Named tick labels by value
+	rangeMode  bool           // Whether two handles are used
+	values     [2]int         // Selected value (index 0) and high value in range mode
+	active     int            // Index of active handle
+	hooks      hooks          // Lifecycle callbacks
+
+	theme  *huh.Theme // Visual theme for consistent styling
+	msgs   localizer  // Translator of built-in strings
+	width  int        // Prompt width
+	done   bool       // Whether selection is completed
+	err    error      // Error state if selection fails
+	valErr error      // Submit veto shown under the slider
+	id     int        // ID sent with the result message
+}
+
+// newSliderModel creates and initializes a slider model from a configured prompt builder.
+// Returns the slider model or an error if bounds or steps are invalid.
+// (ai generated comment)
+func newSliderModel(pb *promptBuilder) (*sliderModel, error) {
+	sm := sliderModel{
+		title:       pb.getTitle(),
+		description: pb.getDescription(),
+		min:         pb.getMin(),
+		max:         pb.getMax(),
+		step:        pb.getStep(),
+		coarseStep:  pb.getCoarseStep(),
+		ticks:       pb.getTickLabels(),
+		rangeMode:   pb.promptType == ptSliderRange,
+		theme:       pb.getTheme(),
+		msgs:        pb.localizer(),
+		width:       pb.getWidth(),
+		hooks:       pb.hooks(),
+		id:          pb.id,
+	}
+	if sm.max <= sm.min {
+		return nil, fmt.Errorf("slider max (%v) must be greater than min (%v)", sm.max, sm.min)
+	}
+	if sm.step <= 0 {
+		return nil, fmt.Errorf("slider step must be positive")
+	}
+	if sm.coarseStep <= 0 {
+		sm.coarseStep = sm.step * 10
+	}
+	sm.values = [2]int{sm.min, sm.min}
+	if sm.rangeMode {
+		sm.values[1] = sm.max
+	}
+	for i, v := range pb.getDefaultNumbers() {
+		if i >= len(sm.values) {
+			break
+		}
+		sm.values[i] = min(max(v, sm.min), sm.max)
+	}
+	if sm.rangeMode && sm.values[0] > sm.values[1] {
+		sm.values[0], sm.values[1] = sm.values[1], sm.values[0]
+	}
+	return &sm, nil
+}
+
+// Init initializes the slider model as required by the Bubble Tea Model interface.
+// (ai generated comment)
+func (sm sliderModel) Init() tea.Cmd {
+	return nil
+}
+
+// resultCmd sends the value (or low and high values in range mode) or the error as result of the prompt.
+// (ai generated comment)
+func (sm sliderModel) resultCmd() tea.Cmd {
+	return sendResult(sm.id, sm.resultValue(), sm.err)
+}
+
+// resultValue returns the value, or low and high values in range mode.
+// (ai generated comment)
+func (sm sliderModel) resultValue() any {
+	if sm.rangeMode {
+		return sm.values
+	}
+	return sm.values[0]
+}
+
+// Update handles messages and updates the slider model state.
+// Left/right move the active handle by a fine step, pgup/pgdown and
+// shift+left/right by a coarse step, home/end jump to the bounds.
+// (ai generated comment)
+func (sm sliderModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	msgKey, ok := msg.(tea.KeyMsg)
+	if !ok || sm.done {
+		return sm, nil
+	}
+	sm.valErr = nil
+	switch msgKey.String() {
+	case "ctrl+c":
+		sm.done = true
+		sm.err = tea.ErrInterrupted
+		sm.hooks.cancel(sm.err)
+		return sm, sm.resultCmd()
+	case "esc":
+		sm.done = true
+		sm.err = sm.msgs.wrap(ErrCanceled, "selection canceled")
+		sm.hooks.cancel(sm.err)
+		return sm, sm.resultCmd()
+	case "enter":
+		if err := sm.hooks.submit(sm.resultValue()); err != nil {
+			sm.valErr = err
+			return sm, nil
+		}
+		sm.done = true
+		return sm, sm.resultCmd()
+	case "tab", "shift+tab":
+		if sm.rangeMode {
+			sm.active = 1 - sm.active
+		}
+	case "left", "h", "down", "j":
+		sm.move(-sm.step)
+	case "right", "l", "up", "k":
+		sm.move(sm.step)
+	case "shift+left", "pgdown":
+		sm.move(-sm.coarseStep)
+	case "shift+right", "pgup":
+		sm.move(sm.coarseStep)
+	case "home":
+		sm.move(sm.min - sm.values[sm.active])
+	case "end":
+		sm.move(sm.max - sm.values[sm.active])
+	}
+	return sm, nil
+}
+
+// move shifts the active handle by delta, snapping to steps and keeping handles ordered.
+// (ai generated comment)
+func (sm *sliderModel) move(delta int) {
+	v := sm.values[sm.active] + delta
+	if v != sm.max {
+		v = sm.min + (v-sm.min)/sm.step*sm.step
+	}
+	low, high := sm.min, sm.max
+	if sm.rangeMode {
+		switch sm.active {
+		case 0:
+			high = sm.values[1]
+		case 1:
+			low = sm.values[0]
+		}
+	}
+	before := sm.values[sm.active]
+	sm.values[sm.active] = min(max(v, low), high)
+	if sm.values[sm.active] != before {
+		sm.hooks.change(sm.resultValue())
+	}
+}
+
+// position converts a value to a cell index on a bar of the given width.
+// (ai generated comment)
+func (sm *sliderModel) position(v, barWidth int) int {
+	return (v - sm.min) * (barWidth - 1) / (sm.max - sm.min)
+}
+
+// barWidth returns the width of the slider bar.
+// (ai generated comment)
+func (sm *sliderModel) barWidth() int {
+	if sm.width <= 0 {
+		return defaultSliderWidth
+	}
+	return max(sm.width-4, 10)
+}
+
+// viewTitle renders the title section of the slider prompt.
+// (ai generated comment)
+func (sm *sliderModel) viewTitle() string {
+	if sm.title == "" {
+		return ""
+	}
+	return sm.theme.Focused.TextInput.Prompt.Render("┃ ") + sm.theme.Focused.Title.Render(sm.title)
+}
+
+// viewDescription renders the description section of the slider prompt.
+// (ai generated comment)
+func (sm *sliderModel) viewDescription() string {
+	if sm.description == "" {
+		return ""
+	}
+	return startLine(sm.theme) + sm.theme.Focused.Description.Render(sm.description)
+}
+
+// viewValue renders the selected value or range with tick labels if defined.
+// (ai generated comment)
+func (sm *sliderModel) viewValue() string {
+	s := sm.formatValue(sm.values[0])
+	if sm.rangeMode {
+		s += " – " + sm.formatValue(sm.values[1])
+	}
+	return startLine(sm.theme) + "value: " + sm.theme.Focused.SelectedOption.Render(s)
+}
+
+// formatValue renders a value with its tick label if one is defined.
+// (ai generated comment)
+func (sm *sliderModel) formatValue(v int) string {
+	if label, ok := sm.ticks[v]; ok {
+		return fmt.Sprintf("%v (%v)", v, label)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// viewBar renders the slider bar with filled part and handles.
+// (ai generated comment)
+func (sm *sliderModel) viewBar() string {
+	width := sm.barWidth()
+	from, to := 0, sm.position(sm.values[0], width)
+	handles := map[int]int{to: 0}
+	if sm.rangeMode {
+		from = sm.position(sm.values[0], width)
+		to = sm.position(sm.values[1], width)
+		handles = map[int]int{from: 0, to: 1}
+	}
+	s := ""
+	for i := range width {
+		if h, ok := handles[i]; ok {
+			switch {
+			case h == sm.active || (sm.rangeMode && from == to):
+				s += sm.theme.Focused.SelectedOption.Render("●")
+			default:
+				s += sm.theme.Focused.Option.Render("●")
+			}
+			continue
+		}
+		switch i >= from && i <= to {
+		case true:
+			s += sm.theme.Focused.SelectedOption.Render("━")
+		case false:
+			s += sm.theme.Focused.TextInput.Placeholder.Render("─")
+		}
+	}
+	return startLine(sm.theme) + s
+}
+
+// viewTicks renders bounds and named tick labels under the bar.
+// Labels that would overlap a previous label are skipped.
+// (ai generated comment)
+func (sm *sliderModel) viewTicks() string {
+	width := sm.barWidth()
+	labels := map[int]string{sm.min: fmt.Sprintf("%v", sm.min), sm.max: fmt.Sprintf("%v", sm.max)}
+	maps.Copy(labels, sm.ticks)
+	line := []rune(strings.Repeat(" ", width))
+	taken := -1
+	for _, v := range slices.Sorted(maps.Keys(labels)) {
+		if v < sm.min || v > sm.max {
+			continue
+		}
+		label := []rune(labels[v])
+		pos := min(sm.position(v, width), width-len(label))
+		pos = max(pos, 0)
+		if pos <= taken {
+			continue
+		}
+		for i, r := range label {
+			if pos+i < len(line) {
+				line[pos+i] = r
+			}
+		}
+		taken = pos + len(label)
+	}
+	return startLine(sm.theme) + sm.theme.Focused.Description.Render(strings.TrimRight(string(line), " "))
+}
+
+// viewError renders the message of the vetoed submit.
+// (ai generated comment)
+func (sm *sliderModel) viewError() string {
+	if sm.valErr == nil {
+		return ""
+	}
+	return startLine(sm.theme) + sm.theme.Focused.ErrorMessage.Render(sm.valErr.Error())
+}
+
+// viewHelp renders the help section with key binding instructions.
+// (ai generated comment)
+func (sm *sliderModel) viewHelp() string {
+	binds := []key.Binding{
+		key.NewBinding(key.WithHelp("←/→", fmt.Sprintf("±%v", sm.step))),
+		key.NewBinding(key.WithHelp("pgup/pgdown", fmt.Sprintf("±%v", sm.coarseStep))),
+	}
+	if sm.rangeMode {
+		binds = append(binds, key.NewBinding(key.WithHelp("tab", "switch handle")))
+	}
+	binds = append(binds,
+		key.NewBinding(key.WithHelp("enter", "submit")),
+		key.NewBinding(key.WithHelp("esc", "cancel")),
+	)
+	return renderHelp(sm.theme, sm.msgs, binds)
+}
+
+// View renders the complete slider prompt interface.
+// Returns empty string if the selection is completed.
+// (ai generated comment)
+func (sm sliderModel) View() string {
+	if sm.done {
+		return ""
+	}
+	s := sm.viewTitle()
+	s += sm.viewDescription()
+	s += sm.viewValue()
+	s += sm.viewBar()
+	s += sm.viewTicks()
+	s += sm.viewError()
+	s += sm.viewHelp()
+	return s
+}
+
+// runSliderModel runs the slider model and returns its final state.
+// (ai generated comment)
+func runSliderModel(pt promptType, opts ...PromptOption) (sliderModel, error) {
+	pb, err := newPromptBuilder(pt, opts...)
+	if err != nil {
+		return sliderModel{}, err
+	}
+	if pb.lineOriented() {
+		values, err := lineSlider(pb)
+		return sliderModel{values: values}, err
+	}
+	slider, err := newSliderModel(pb)
+	if err != nil {
+		return sliderModel{}, err
+	}
+	resultState, err := runModel(pb, slider)
+	if err != nil {
+		return sliderModel{}, err
+	}
+	if finalModel, ok := resultState.(sliderModel); ok {
+		return finalModel, finalModel.err
+	}
+	return sliderModel{}, fmt.Errorf("unexpected endpoint reached")
+}
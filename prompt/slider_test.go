@@ -0,0 +1,88 @@
+package prompt
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// newTestSliderModel creates a slider model from the given options for tests
+func newTestSliderModel(t *testing.T, pt promptType, opts ...PromptOption) tea.Model {
+	sm, err := newSliderModel(newTestBuilder(t, pt, opts...))
+	if err != nil {
+		t.Fatalf("newSliderModel() error = %v", err)
+	}
+	return *sm
+}
+
+// TestSliderModelSteps tests fine and coarse steps with clamping
+func TestSliderModelSteps(t *testing.T) {
+	m := newTestSliderModel(t, ptSlider, WithMin(0), WithMax(50), WithStep(5))
+
+	m = pressKeys(m, tea.KeyRight, tea.KeyRight)
+	if got := m.(sliderModel).values[0]; got != 10 {
+		t.Errorf("value after fine steps = %d, want 10", got)
+	}
+	m = pressKeys(m, tea.KeyPgUp)
+	if got := m.(sliderModel).values[0]; got != 50 {
+		t.Errorf("value after coarse step = %d, want clamped 50", got)
+	}
+	m = pressKeys(m, tea.KeyHome)
+	if got := m.(sliderModel).values[0]; got != 0 {
+		t.Errorf("value after home = %d, want 0", got)
+	}
+	m = pressKeys(m, tea.KeyLeft)
+	if got := m.(sliderModel).values[0]; got != 0 {
+		t.Errorf("value below min = %d, want 0", got)
+	}
+}
+
+// TestSliderModelRange tests that range handles can not pass each other
+func TestSliderModelRange(t *testing.T) {
+	m := newTestSliderModel(t, ptSliderRange, WithMin(1), WithMax(10), WithCoarseStep(3))
+
+	m = pressKeys(m, tea.KeyPgUp, tea.KeyTab, tea.KeyPgDown, tea.KeyPgDown, tea.KeyPgDown)
+	sm := m.(sliderModel)
+	if sm.values[0] != 4 || sm.values[1] != 4 {
+		t.Errorf("range = %d-%d, want 4-4", sm.values[0], sm.values[1])
+	}
+	m = pressKeys(m, tea.KeyEnd, tea.KeyEnter)
+	sm = m.(sliderModel)
+	if !sm.done || sm.values[1] != 10 {
+		t.Errorf("submitted range = %d-%d (done %v), want 4-10", sm.values[0], sm.values[1], sm.done)
+	}
+}
+
+// TestSliderModelView tests rendering of tick labels
+func TestSliderModelView(t *testing.T) {
+	m := newTestSliderModel(t, ptSlider, WithTickLabels(map[int]string{0: "off", 50: "half"}), WithWidth(44))
+
+	view := m.View()
+	for _, want := range []string{"0 (off)", "half", "100"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("View() should contain %q", want)
+		}
+	}
+}
+
+// TestSliderModelInvalidBounds tests constructor errors
+func TestSliderModelInvalidBounds(t *testing.T) {
+	if _, err := newSliderModel(newTestBuilder(t, ptSlider, WithMin(10), WithMax(10))); err == nil {
+		t.Error("newSliderModel() should fail when max is not greater than min")
+	}
+	if _, err := newSliderModel(newTestBuilder(t, ptSlider, WithStep(0))); err == nil {
+		t.Error("newSliderModel() should fail when step is not positive")
+	}
+}
+
+// TestSliderModelDefaultNumbers tests initial values with clamping and ordering
+func TestSliderModelDefaultNumbers(t *testing.T) {
+	if got := newTestSliderModel(t, ptSlider, WithDefaultNumbers(42)).(sliderModel).values[0]; got != 42 {
+		t.Errorf("slider value = %v, want 42", got)
+	}
+	got := newTestSliderModel(t, ptSliderRange, WithDefaultNumbers(150, 30)).(sliderModel).values
+	if got != [2]int{30, 100} {
+		t.Errorf("range values = %v, want [30 100]", got)
+	}
+}
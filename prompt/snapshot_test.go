@@ -0,0 +1,172 @@
+package prompt
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// updateGolden rewrites golden files with the rendered views: go test ./prompt -run Snapshot -update
+var updateGolden = flag.Bool("update", false, "update golden files under testdata")
+
+// snapshotProfiles are color profiles every snapshot is rendered with
+var snapshotProfiles = []struct {
+	name    string
+	profile termenv.Profile
+}{
+	{"nocolor", termenv.Ascii},
+	{"color", termenv.TrueColor},
+}
+
+// useColorProfile renders styles with the profile on dark background until the test ends
+func useColorProfile(t *testing.T, profile termenv.Profile) {
+	t.Helper()
+	prevProfile, prevDark := lipgloss.ColorProfile(), lipgloss.HasDarkBackground()
+	lipgloss.SetColorProfile(profile)
+	lipgloss.SetHasDarkBackground(true)
+	t.Cleanup(func() {
+		lipgloss.SetColorProfile(prevProfile)
+		lipgloss.SetHasDarkBackground(prevDark)
+	})
+}
+
+// snapshotOptions returns options making rendering independent of environment and locale
+func snapshotOptions(opts ...PromptOption) []PromptOption {
+	return append([]PromptOption{WithTheme(huh.ThemeCharm()), WithLanguage("en")}, opts...)
+}
+
+// assertGolden compares the view with the golden file testdata/snapshots/<name>.golden
+func assertGolden(t *testing.T, name, view string) {
+	t.Helper()
+	path := filepath.Join("testdata", "snapshots", name+".golden")
+	if *updateGolden {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(view), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file (run with -update to create it): %v", err)
+	}
+	if view == string(want) {
+		return
+	}
+	got, exp := strings.Split(view, "\n"), strings.Split(string(want), "\n")
+	for i := range max(len(got), len(exp)) {
+		g, w := "", ""
+		if i < len(got) {
+			g = got[i]
+		}
+		if i < len(exp) {
+			w = exp[i]
+		}
+		if g != w {
+			t.Errorf("view differs from %v at line %v:\n got: %q\nwant: %q", path, i+1, g, w)
+			return
+		}
+	}
+}
+
+// snapshot renders the model after the key presses with every color profile and compares the views with golden files
+func snapshot(t *testing.T, name string, build func() tea.Model, keys ...tea.KeyType) {
+	t.Helper()
+	for _, p := range snapshotProfiles {
+		t.Run(p.name, func(t *testing.T) {
+			useColorProfile(t, p.profile)
+			assertGolden(t, name+"_"+p.name, pressKeys(build(), keys...).View())
+		})
+	}
+}
+
+// fruits returns items with the keys fruit00 to fruitNN
+func fruits(n int) []*Item {
+	items := []*Item{}
+	for i := range n {
+		items = append(items, NewItem(fmt.Sprintf("fruit%02d", i)))
+	}
+	return items
+}
+
+// TestSnapshotSearch tests rendered search views: sections, filtering and scrolling
+func TestSnapshotSearch(t *testing.T) {
+	basket := func() []*Item { return NewItemList("apple", "banana", "cherry", "mango") }
+	tests := []struct {
+		name string
+		opts []PromptOption
+		keys []tea.KeyType
+	}{
+		{"plain", []PromptOption{FromItems(basket())}, nil},
+		{"title", []PromptOption{FromItems(basket()), WithTitle("Fruit")}, nil},
+		{"description", []PromptOption{FromItems(basket()), WithDescription("pick one")}, nil},
+		{"title_description", []PromptOption{FromItems(basket()), WithTitle("Fruit"), WithDescription("pick one")}, nil},
+		{"filter", []PromptOption{FromItems(basket()), WithTitle("Fruit"), WithDefaultValue("an")}, nil},
+		{"filter_cursor", []PromptOption{FromItems(basket()), WithDefaultValue("an")}, []tea.KeyType{tea.KeyDown}},
+		{"filter_no_match", []PromptOption{FromItems(basket()), WithDefaultValue("kiwi")}, nil},
+		{"decorated", []PromptOption{FromItems([]*Item{
+			NewItem("apple").WithGroup("fruit").WithHint("new"),
+			NewItem("pear").WithGroup("fruit").WithDisabled("out of stock"),
+			NewItem("carrot").WithGroup("vegetables").WithDescription("orange root"),
+		})}, nil},
+		{"veto", []PromptOption{FromItems(basket()), WithOnSubmit(func(any) error { return errors.New("not in season") })}, []tea.KeyType{tea.KeyEnter}},
+		{"scroll_top", []PromptOption{FromItems(fruits(30)), WithTitle("Fruit")}, nil},
+		{"scroll_middle", []PromptOption{FromItems(fruits(30)), WithTitle("Fruit")}, []tea.KeyType{tea.KeyPgDown, tea.KeyDown}},
+		{"scroll_bottom", []PromptOption{FromItems(fruits(30)), WithTitle("Fruit")}, []tea.KeyType{tea.KeyPgDown, tea.KeyPgDown, tea.KeyPgDown}},
+		{"scroll_back", []PromptOption{FromItems(fruits(30)), WithTitle("Fruit")}, []tea.KeyType{tea.KeyPgDown, tea.KeyPgDown, tea.KeyPgUp, tea.KeyUp}},
+		{"scroll_tall", []PromptOption{FromItems(fruits(30)), WithTitle("Fruit"), WithHeight(30)}, []tea.KeyType{tea.KeyPgDown}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshot(t, "search_"+tt.name, func() tea.Model {
+				sm, err := newSearch(newTestBuilder(t, ptSearch, snapshotOptions(tt.opts...)...))
+				if err != nil {
+					t.Fatal(err)
+				}
+				return *sm
+			}, tt.keys...)
+		})
+	}
+}
+
+// TestSnapshotPrompts tests rendered views of other prompts at fixed widths
+func TestSnapshotPrompts(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func(opts ...PromptOption) (Model, error)
+		opts  []PromptOption
+	}{
+		{"input", NewInputModel, []PromptOption{WithTitle("Name"), WithPlaceholder("your name")}},
+		{"select", NewSelectModel, []PromptOption{WithTitle("Fruit"), FromItems(NewItemList("apple", "banana"))}},
+		{"select_multiple", NewSelectMultipleModel, []PromptOption{WithTitle("Fruit"), FromItems(NewItemList("apple", "banana")), WithSelected("banana")}},
+		{"input_list", NewInputListModel, []PromptOption{WithTitle("Tags"), WithDefaultValues("alpha", "beta", "gamma", "delta", "epsilon", "zeta")}},
+		{"edit_map", NewEditMapModel, []PromptOption{WithTitle("Env"), FromMap(map[string]string{"HOME": "/home/user", "SHELL": "/bin/bash"})}},
+		{"slider", NewSliderModel, []PromptOption{WithTitle("Volume"), WithMin(0), WithMax(10), WithDefaultNumbers(3)}},
+		{"slider_range", NewSliderRangeModel, []PromptOption{WithTitle("Range"), WithMin(0), WithMax(10), WithDefaultNumbers(2, 8)}},
+	}
+	for _, tt := range tests {
+		for _, width := range []int{30, 80} {
+			name := fmt.Sprintf("%v_w%v", tt.name, width)
+			t.Run(name, func(t *testing.T) {
+				snapshot(t, name, func() tea.Model {
+					model, err := tt.build(snapshotOptions(append(tt.opts, WithWidth(width))...)...)
+					if err != nil {
+						t.Fatal(err)
+					}
+					return model
+				})
+			})
+		}
+	}
+}
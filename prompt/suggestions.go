@@ -0,0 +1,112 @@
+package prompt
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// SuggestionProviderFunc is a function type that supplies autocomplete suggestions.
+// It receives the text currently typed by the user and returns candidate completions.
+// Used by the Input prompt to power tab completion, ghost text and the suggestion dropdown.
+// (ai generated comment)
+type SuggestionProviderFunc func(prefix string) []string
+
+// defaultSuggestionProviderFunc is the default suggestion provider.
+// It is nil, meaning Input runs without autocomplete.
+// (ai generated comment)
+var defaultSuggestionProviderFunc SuggestionProviderFunc = nil
+
+// StaticSuggestions creates a provider from a fixed list of suggestions.
+// Returns only those suggestions that start with the typed prefix (case insensitive).
+// (ai generated comment)
+func StaticSuggestions(suggestions ...string) SuggestionProviderFunc {
+	list := make([]string, len(suggestions))
+	copy(list, suggestions)
+	return func(prefix string) []string {
+		return filterByPrefix(list, prefix)
+	}
+}
+
+// ItemSuggestions creates a provider that suggests keys of the given items.
+// Useful when free text input should still be guided by an existing item pool.
+// (ai generated comment)
+func ItemSuggestions(items []*Item) SuggestionProviderFunc {
+	keys := []string{}
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		keys = append(keys, item.key)
+	}
+	return StaticSuggestions(keys...)
+}
+
+// PathSuggestions suggests filesystem paths matching the typed prefix.
+// Directory entries are completed with a trailing path separator so completion can continue.
+// Hidden entries are only suggested when the prefix explicitly starts with a dot.
+// (ai generated comment)
+func PathSuggestions(prefix string) []string {
+	dir, base := filepath.Split(prefix)
+	readDir := dir
+	if readDir == "" {
+		readDir = "."
+	}
+	entries, err := os.ReadDir(readDir)
+	if err != nil {
+		return nil
+	}
+	list := []string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, ".") && !strings.HasPrefix(base, ".") {
+			continue
+		}
+		if !strings.HasPrefix(name, base) {
+			continue
+		}
+		suggestion := dir + name
+		if entry.IsDir() {
+			suggestion += string(filepath.Separator)
+		}
+		list = append(list, suggestion)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// EnvSuggestions suggests names of environment variables matching the typed prefix.
+// A leading '$' in the prefix is preserved in the returned suggestions.
+// (ai generated comment)
+func EnvSuggestions(prefix string) []string {
+	sign := ""
+	if strings.HasPrefix(prefix, "$") {
+		sign = "$"
+	}
+	name := strings.TrimPrefix(prefix, "$")
+	list := []string{}
+	for _, env := range os.Environ() {
+		key, _, _ := strings.Cut(env, "=")
+		if key == "" || !strings.HasPrefix(key, name) {
+			continue
+		}
+		list = append(list, sign+key)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// filterByPrefix returns the elements of list starting with prefix, ignoring case.
+// Keeps the original order of the list.
+// (ai generated comment)
+func filterByPrefix(list []string, prefix string) []string {
+	lowerPrefix := strings.ToLower(prefix)
+	filtered := []string{}
+	for _, s := range list {
+		if strings.HasPrefix(strings.ToLower(s), lowerPrefix) {
+			filtered = append(filtered, s)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,96 @@
+package prompt
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// TestStaticSuggestions tests prefix filtering of static suggestions
+func TestStaticSuggestions(t *testing.T) {
+	provider := StaticSuggestions("apple", "Apricot", "banana")
+
+	tests := []struct {
+		prefix   string
+		expected []string
+	}{
+		{"", []string{"apple", "Apricot", "banana"}},
+		{"ap", []string{"apple", "Apricot"}},
+		{"AP", []string{"apple", "Apricot"}},
+		{"b", []string{"banana"}},
+		{"x", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			got := provider(tt.prefix)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("StaticSuggestions(%q) = %v, want %v", tt.prefix, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestItemSuggestions tests that item keys are used as suggestions
+func TestItemSuggestions(t *testing.T) {
+	provider := ItemSuggestions([]*Item{NewItem("alpha", 1), nil, NewItem("beta", 2)})
+
+	got := provider("a")
+	if !reflect.DeepEqual(got, []string{"alpha"}) {
+		t.Errorf("ItemSuggestions(\"a\") = %v, want [alpha]", got)
+	}
+}
+
+// TestPathSuggestions tests filesystem path suggestions
+func TestPathSuggestions(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"notes.txt", "nested", ".hidden"} {
+		path := filepath.Join(dir, name)
+		if name == "nested" {
+			if err := os.Mkdir(path, 0o755); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		if err := os.WriteFile(path, nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	prefix := dir + string(filepath.Separator)
+
+	got := PathSuggestions(prefix + "n")
+	expected := []string{
+		prefix + "nested" + string(filepath.Separator),
+		prefix + "notes.txt",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("PathSuggestions() = %v, want %v", got, expected)
+	}
+
+	got = PathSuggestions(prefix + ".")
+	if !reflect.DeepEqual(got, []string{prefix + ".hidden"}) {
+		t.Errorf("PathSuggestions() hidden = %v, want %v", got, []string{prefix + ".hidden"})
+	}
+
+	if got := PathSuggestions(filepath.Join(dir, "missing", "x")); len(got) != 0 {
+		t.Errorf("PathSuggestions() for missing dir = %v, want empty", got)
+	}
+}
+
+// TestEnvSuggestions tests environment variable name suggestions
+func TestEnvSuggestions(t *testing.T) {
+	t.Setenv("CONSOLIO_TEST_SUGGEST_A", "1")
+	t.Setenv("CONSOLIO_TEST_SUGGEST_B", "2")
+
+	got := EnvSuggestions("CONSOLIO_TEST_SUGGEST_")
+	expected := []string{"CONSOLIO_TEST_SUGGEST_A", "CONSOLIO_TEST_SUGGEST_B"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("EnvSuggestions() = %v, want %v", got, expected)
+	}
+
+	got = EnvSuggestions("$CONSOLIO_TEST_SUGGEST_A")
+	if !reflect.DeepEqual(got, []string{"$CONSOLIO_TEST_SUGGEST_A"}) {
+		t.Errorf("EnvSuggestions() with $ = %v, want [$CONSOLIO_TEST_SUGGEST_A]", got)
+	}
+}
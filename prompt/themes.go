@@ -0,0 +1,309 @@
+package prompt
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ThemeEnv is the environment variable selecting the default theme by name or theme file path.
+// (ai generated comment)
+const ThemeEnv = "CONSOLIO_THEME"
+
+// defaultThemeName is the theme used when no theme is selected.
+// (ai generated comment)
+const defaultThemeName = "base16"
+
+var (
+	themes = map[string]func() *huh.Theme{
+		"base":       huh.ThemeBase,
+		"base16":     huh.ThemeBase16,
+		"charm":      huh.ThemeCharm,
+		"dracula":    huh.ThemeDracula,
+		"catppuccin": huh.ThemeCatppuccin,
+	}
+	themesMu sync.RWMutex
+)
+
+// RegisterTheme adds a copy of the theme to the catalogue under a case-insensitive name,
+// replacing a theme registered with the same name. ThemeByName returns a new copy every time,
+// so changing a returned theme does not change the registered one.
+// (ai generated comment)
+func RegisterTheme(name string, theme *huh.Theme) {
+	registered := *theme
+	themesMu.Lock()
+	defer themesMu.Unlock()
+	themes[strings.ToLower(name)] = func() *huh.Theme {
+		theme := registered
+		return &theme
+	}
+}
+
+// ThemeNames returns sorted names of all themes in the catalogue.
+// (ai generated comment)
+func ThemeNames() []string {
+	themesMu.RLock()
+	defer themesMu.RUnlock()
+	return slices.Sorted(maps.Keys(themes))
+}
+
+// ThemeByName returns the theme registered under the name.
+// (ai generated comment)
+func ThemeByName(name string) (*huh.Theme, error) {
+	themesMu.RLock()
+	theme, ok := themes[strings.ToLower(strings.TrimSpace(name))]
+	themesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown theme %q (known: %v)", name, strings.Join(ThemeNames(), ", "))
+	}
+	return theme(), nil
+}
+
+// ResolveTheme returns a theme by name or, if the value is a path to a .json, .yaml, .yml or .toml file,
+// loads it with LoadThemeFile.
+// (ai generated comment)
+func ResolveTheme(nameOrPath string) (*huh.Theme, error) {
+	if _, err := formatOf(nameOrPath); err == nil {
+		return LoadThemeFile(nameOrPath)
+	}
+	return ThemeByName(nameOrPath)
+}
+
+// defaultTheme returns the theme selected with CONSOLIO_THEME or base16 if it is not set or invalid.
+// (ai generated comment)
+func defaultTheme() *huh.Theme {
+	if selected := os.Getenv(ThemeEnv); selected != "" {
+		if theme, err := ResolveTheme(selected); err == nil {
+			return theme
+		}
+	}
+	theme, _ := ThemeByName(defaultThemeName)
+	return theme
+}
+
+// LoadThemeFile loads a theme from a JSON, YAML or TOML description.
+// The description names a catalogue theme to start from, a palette of named colors
+// and styles of theme elements:
+//
+//	base: charm
+//	colors:
+//	  accent: "#7D56F4"
+//	  muted: {light: "#909090", dark: "#5C5C5C"}
+//	styles:
+//	  title: {foreground: accent, bold: true}
+//	  description: {foreground: muted}
+//
+// Elements are title, description, prompt, text, placeholder, cursor, option, selected_option,
+// error_message, note_title, help_key, help_desc and help_separator. Style attributes are
+// foreground, background (palette name, hex or ANSI color, or light/dark pair),
+// bold, italic, underline and faint.
+// (ai generated comment)
+func LoadThemeFile(path string) (*huh.Theme, error) {
+	format, err := formatOf(path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read theme: %v", err)
+	}
+	doc, err := decodeConfig(format, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse theme %v: %v", path, err)
+	}
+	theme, err := themeFromDescription(doc)
+	if err != nil {
+		return nil, fmt.Errorf("bad theme %v: %v", path, err)
+	}
+	return theme, nil
+}
+
+// themeFromDescription builds a theme from a decoded theme description.
+// (ai generated comment)
+func themeFromDescription(doc map[string]any) (*huh.Theme, error) {
+	base := "base"
+	if raw, ok := doc["base"]; ok {
+		name, err := configString(raw)
+		if err != nil {
+			return nil, fmt.Errorf("base: %v", err)
+		}
+		base = name
+	}
+	theme, err := ThemeByName(base)
+	if err != nil {
+		return nil, err
+	}
+	palette := map[string]lipgloss.TerminalColor{}
+	if raw, ok := doc["colors"]; ok {
+		colors, err := configMap(raw)
+		if err != nil {
+			return nil, fmt.Errorf("colors: %v", err)
+		}
+		for name, value := range colors {
+			if palette[name], err = themeColor(value, nil); err != nil {
+				return nil, fmt.Errorf("colors.%v: %v", name, err)
+			}
+		}
+	}
+	if raw, ok := doc["styles"]; ok {
+		styles, err := configMap(raw)
+		if err != nil {
+			return nil, fmt.Errorf("styles: %v", err)
+		}
+		for _, element := range sortedKeys(styles) {
+			if err := applyThemeStyle(theme, element, styles[element], palette); err != nil {
+				return nil, fmt.Errorf("styles.%v: %v", element, err)
+			}
+		}
+	}
+	for key := range doc {
+		if key != "base" && key != "colors" && key != "styles" {
+			return nil, fmt.Errorf("unknown section %q", key)
+		}
+	}
+	return theme, nil
+}
+
+// themeElements returns pointers to theme styles by element name.
+// Focused and blurred variants of field styles are changed together.
+// (ai generated comment)
+func themeElements(theme *huh.Theme, element string) []*lipgloss.Style {
+	switch element {
+	case "title":
+		return []*lipgloss.Style{&theme.Focused.Title, &theme.Blurred.Title}
+	case "description":
+		return []*lipgloss.Style{&theme.Focused.Description, &theme.Blurred.Description}
+	case "prompt":
+		return []*lipgloss.Style{&theme.Focused.TextInput.Prompt, &theme.Blurred.TextInput.Prompt}
+	case "text":
+		return []*lipgloss.Style{&theme.Focused.TextInput.Text, &theme.Blurred.TextInput.Text}
+	case "placeholder":
+		return []*lipgloss.Style{&theme.Focused.TextInput.Placeholder, &theme.Blurred.TextInput.Placeholder}
+	case "cursor":
+		return []*lipgloss.Style{&theme.Focused.TextInput.Cursor, &theme.Blurred.TextInput.Cursor}
+	case "option":
+		return []*lipgloss.Style{&theme.Focused.Option, &theme.Focused.UnselectedOption, &theme.Blurred.Option, &theme.Blurred.UnselectedOption}
+	case "selected_option":
+		return []*lipgloss.Style{&theme.Focused.SelectedOption, &theme.Focused.SelectSelector, &theme.Blurred.SelectedOption}
+	case "error_message":
+		return []*lipgloss.Style{&theme.Focused.ErrorMessage, &theme.Focused.ErrorIndicator, &theme.Blurred.ErrorMessage, &theme.Blurred.ErrorIndicator}
+	case "note_title":
+		return []*lipgloss.Style{&theme.Focused.NoteTitle, &theme.Blurred.NoteTitle}
+	case "help_key":
+		return []*lipgloss.Style{&theme.Help.ShortKey, &theme.Help.FullKey}
+	case "help_desc":
+		return []*lipgloss.Style{&theme.Help.ShortDesc, &theme.Help.FullDesc}
+	case "help_separator":
+		return []*lipgloss.Style{&theme.Help.ShortSeparator, &theme.Help.FullSeparator}
+	}
+	return nil
+}
+
+// applyThemeStyle applies style attributes of a description to the theme element.
+// (ai generated comment)
+func applyThemeStyle(theme *huh.Theme, element string, raw any, palette map[string]lipgloss.TerminalColor) error {
+	targets := themeElements(theme, element)
+	if targets == nil {
+		return fmt.Errorf("unknown element")
+	}
+	attrs, err := configMap(raw)
+	if err != nil {
+		return err
+	}
+	for _, attr := range sortedKeys(attrs) {
+		value := attrs[attr]
+		var apply func(lipgloss.Style) lipgloss.Style
+		switch attr {
+		case "foreground", "background":
+			color, err := themeColor(value, palette)
+			if err != nil {
+				return fmt.Errorf("%v: %v", attr, err)
+			}
+			apply = func(s lipgloss.Style) lipgloss.Style { return s.Foreground(color) }
+			if attr == "background" {
+				apply = func(s lipgloss.Style) lipgloss.Style { return s.Background(color) }
+			}
+		case "bold", "italic", "underline", "faint":
+			on, err := configBool(value)
+			if err != nil {
+				return fmt.Errorf("%v: %v", attr, err)
+			}
+			apply = map[string]func(lipgloss.Style) lipgloss.Style{
+				"bold":      func(s lipgloss.Style) lipgloss.Style { return s.Bold(on) },
+				"italic":    func(s lipgloss.Style) lipgloss.Style { return s.Italic(on) },
+				"underline": func(s lipgloss.Style) lipgloss.Style { return s.Underline(on) },
+				"faint":     func(s lipgloss.Style) lipgloss.Style { return s.Faint(on) },
+			}[attr]
+		default:
+			return fmt.Errorf("unknown attribute %q", attr)
+		}
+		for _, target := range targets {
+			*target = apply(*target)
+		}
+	}
+	return nil
+}
+
+// themeColor converts a color description to a terminal color: palette name,
+// hex or ANSI color string, or mapping with light and dark colors.
+// (ai generated comment)
+func themeColor(raw any, palette map[string]lipgloss.TerminalColor) (lipgloss.TerminalColor, error) {
+	if mapping, ok := raw.(map[string]any); ok {
+		if len(mapping) != 2 {
+			return nil, fmt.Errorf("expected light and dark colors")
+		}
+		light, errLight := themeShade(mapping["light"], palette, false)
+		dark, errDark := themeShade(mapping["dark"], palette, true)
+		if err := cmp.Or(errLight, errDark); err != nil {
+			return nil, fmt.Errorf("expected light and dark colors: %v", err)
+		}
+		return lipgloss.AdaptiveColor{Light: light, Dark: dark}, nil
+	}
+	s, err := configString(raw)
+	if err != nil {
+		return nil, err
+	}
+	if color, ok := palette[s]; ok {
+		return color, nil
+	}
+	if isColorCode(s) {
+		return lipgloss.Color(s), nil
+	}
+	return nil, fmt.Errorf("unknown color %q", s)
+}
+
+// themeShade converts the light or dark part of a color pair to a color code.
+// Palette names resolve to the palette color, or to its light or dark variant for light/dark pairs.
+// (ai generated comment)
+func themeShade(raw any, palette map[string]lipgloss.TerminalColor, dark bool) (string, error) {
+	s, err := configString(raw)
+	if err != nil {
+		return "", err
+	}
+	switch color := palette[s].(type) {
+	case lipgloss.Color:
+		return string(color), nil
+	case lipgloss.AdaptiveColor:
+		if dark {
+			return color.Dark, nil
+		}
+		return color.Light, nil
+	}
+	if isColorCode(s) {
+		return s, nil
+	}
+	return "", fmt.Errorf("unknown color %q", s)
+}
+
+// isColorCode reports whether the string is a hex or ANSI color code.
+// (ai generated comment)
+func isColorCode(s string) bool {
+	return strings.HasPrefix(s, "#") || strings.Trim(s, "0123456789") == "" && s != ""
+}
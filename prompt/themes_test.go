@@ -0,0 +1,129 @@
+package prompt
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// TestThemeCatalogue tests lookup of built-in and registered themes
+func TestThemeCatalogue(t *testing.T) {
+	for _, name := range []string{"base", "Base16", "charm", "dracula", "catppuccin"} {
+		if theme, err := ThemeByName(name); err != nil || theme == nil {
+			t.Errorf("ThemeByName(%v) = %v, %v", name, theme, err)
+		}
+	}
+	if _, err := ThemeByName("neon"); err == nil || !strings.Contains(err.Error(), "dracula") {
+		t.Errorf("ThemeByName(neon) error = %v, want list of known themes", err)
+	}
+	custom := huh.ThemeBase()
+	custom.Focused.Title = custom.Focused.Title.Foreground(lipgloss.Color("#ABCDEF"))
+	RegisterTheme("Test-Custom", custom)
+	theme, err := ThemeByName("test-custom")
+	if err != nil || theme.Focused.Title.GetForeground() != lipgloss.Color("#ABCDEF") {
+		t.Fatalf("registered theme should be found case-insensitively: %v", err)
+	}
+	if theme == custom {
+		t.Error("ThemeByName() should return a copy of the registered theme")
+	}
+	path := writeFile(t, t.TempDir(), "theme.json", `{"base": "test-custom", "styles": {"title": {"foreground": "#123456"}}}`)
+	if _, err := LoadThemeFile(path); err != nil {
+		t.Fatal(err)
+	}
+	if theme, _ := ThemeByName("test-custom"); theme.Focused.Title.GetForeground() != lipgloss.Color("#ABCDEF") {
+		t.Errorf("theme file based on test-custom changed the registered title to %v", theme.Focused.Title.GetForeground())
+	}
+	if !slices.Contains(ThemeNames(), "test-custom") {
+		t.Errorf("ThemeNames() = %v, want test-custom", ThemeNames())
+	}
+}
+
+// TestLoadThemeFile tests building themes from YAML and JSON descriptions
+func TestLoadThemeFile(t *testing.T) {
+	dir := t.TempDir()
+	path := writeFile(t, dir, "theme.yaml", `
+base: charm
+colors:
+  accent: "#7D56F4"
+  muted: {light: "#909090", dark: "#5C5C5C"}
+styles:
+  title: {foreground: accent, bold: true}
+  description:
+    foreground: muted
+  help_key: {foreground: "212"}
+  note_title: {foreground: {light: accent, dark: muted}}
+`)
+	theme, err := LoadThemeFile(path)
+	if err != nil {
+		t.Fatalf("LoadThemeFile() error = %v", err)
+	}
+	if got := theme.Focused.Title.GetForeground(); got != lipgloss.Color("#7D56F4") || !theme.Focused.Title.GetBold() {
+		t.Errorf("title foreground = %v, bold %v", got, theme.Focused.Title.GetBold())
+	}
+	if got := theme.Blurred.Description.GetForeground(); got != (lipgloss.AdaptiveColor{Light: "#909090", Dark: "#5C5C5C"}) {
+		t.Errorf("description foreground = %v", got)
+	}
+	if got := theme.Help.FullKey.GetForeground(); got != lipgloss.Color("212") {
+		t.Errorf("help key foreground = %v", got)
+	}
+	if got := theme.Focused.NoteTitle.GetForeground(); got != (lipgloss.AdaptiveColor{Light: "#7D56F4", Dark: "#5C5C5C"}) {
+		t.Errorf("note title foreground = %v, want palette colors", got)
+	}
+
+	for name, content := range map[string]string{
+		"element.json": `{"styles": {"frame": {"bold": true}}}`,
+		"color.json":   `{"styles": {"title": {"foreground": "accent"}}}`,
+		"attr.json":    `{"styles": {"title": {"blink": true}}}`,
+		"base.json":    `{"base": "neon"}`,
+		"shade.json":   `{"styles": {"title": {"foreground": {"light": "accent", "dark": "#000000"}}}}`,
+		"section.json": `{"palette": {}}`,
+	} {
+		if _, err := LoadThemeFile(writeFile(t, dir, name, content)); err == nil {
+			t.Errorf("LoadThemeFile(%v) should fail", name)
+		}
+	}
+}
+
+// TestThemeFromEnv tests selection of the default theme with CONSOLIO_THEME
+func TestThemeFromEnv(t *testing.T) {
+	t.Setenv(ThemeEnv, "dracula")
+	dracula := huh.ThemeDracula()
+	if got := defaultTheme().Focused.Title.GetForeground(); got != dracula.Focused.Title.GetForeground() {
+		t.Errorf("default theme title = %v, want dracula", got)
+	}
+	path := writeFile(t, t.TempDir(), "theme.json", `{"styles": {"title": {"foreground": "#123456"}}}`)
+	t.Setenv(ThemeEnv, path)
+	if got := newTestBuilder(t, ptInput).getTheme().Focused.Title.GetForeground(); got != lipgloss.Color("#123456") {
+		t.Errorf("theme from file title = %v", got)
+	}
+	t.Setenv(ThemeEnv, filepath.Join(t.TempDir(), "missing.yaml"))
+	if defaultTheme() == nil {
+		t.Error("invalid theme selection should fall back to default theme")
+	}
+}
+
+// TestSearchViewThemedLines tests that every search line starts with the themed bar
+func TestSearchViewThemedLines(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(profile)
+	theme := huh.ThemeCharm()
+	sm, err := newSearch(newTestBuilder(t, ptSearch,
+		FromItems(NewItemList("alpha", "beta", "gamma")),
+		WithTheme(theme), WithDescription("pick one"), WithHeight(8)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bar := theme.Focused.TextInput.Prompt.Render("┃ ")
+	lines := strings.Split(sm.View(), "\n\n")[0]
+	for i, line := range strings.Split(lines, "\n") {
+		if !strings.HasPrefix(line, bar) {
+			t.Errorf("line %v = %q, want themed bar prefix", i, line)
+		}
+	}
+}
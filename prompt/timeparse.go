@@ -0,0 +1,298 @@
+package prompt
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// durationUnits maps duration unit suffixes to their length.
+// Extends units known by time.ParseDuration with days and weeks.
+// (ai generated comment)
+var durationUnits = map[string]time.Duration{
+	"ns": time.Nanosecond,
+	"us": time.Microsecond,
+	"µs": time.Microsecond,
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  24 * time.Hour,
+	"w":  7 * 24 * time.Hour,
+}
+
+// durationPartRe matches a single number+unit part of a duration expression.
+// (ai generated comment)
+var durationPartRe = regexp.MustCompile(`^([0-9]*\.?[0-9]+)(ns|us|µs|ms|s|m|h|d|w)`)
+
+// offsetPartRe matches a single number+unit part of a relative time offset.
+// Months ("mo") and years ("y") are applied as calendar offsets.
+// (ai generated comment)
+var offsetPartRe = regexp.MustCompile(`^([0-9]+)(mo|y)`)
+
+// clockRe matches time of day expressions like "9am", "9:30pm", "17:00" or "17:00:30".
+// (ai generated comment)
+var clockRe = regexp.MustCompile(`^([0-9]{1,2})(?::([0-9]{2}))?(?::([0-9]{2}))?(am|pm)?$`)
+
+// ParseDuration parses a duration expression such as "90m", "1h30m", "3d" or "2w12h".
+// Supports all units of time.ParseDuration plus "d" (days) and "w" (weeks).
+// Returns an error if expression is empty or contains unknown parts.
+// (ai generated comment)
+func ParseDuration(expr string) (time.Duration, error) {
+	s := strings.ToLower(strings.TrimSpace(expr))
+	s = strings.ReplaceAll(s, " ", "")
+	if s == "" {
+		return 0, fmt.Errorf("empty duration")
+	}
+	sign := time.Duration(1)
+	switch s[0] {
+	case '-':
+		sign = -1
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+	if s == "" {
+		return 0, fmt.Errorf("invalid duration %q", expr)
+	}
+	if s == "0" {
+		return 0, nil
+	}
+	total := 0.0
+	for s != "" {
+		match := durationPartRe.FindStringSubmatch(s)
+		if match == nil {
+			return 0, fmt.Errorf("invalid duration %q", expr)
+		}
+		n, err := strconv.ParseFloat(match[1], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %v", expr, err)
+		}
+		total += n * float64(durationUnits[match[2]])
+		s = s[len(match[0]):]
+	}
+	return sign * time.Duration(total), nil
+}
+
+// ParseTime parses an absolute or relative time expression.
+// Accepts values formatted with layout as well as expressions like "now", "today",
+// "tomorrow 9am", "next friday", "+3d", "-2w", "+1mo", "noon" or "17:30".
+// Relative expressions are resolved against now; result uses location of now.
+// A time of day applies to the resolved date whatever the token order ("9am tomorrow").
+// (ai generated comment)
+func ParseTime(expr string, now time.Time, layout string) (time.Time, error) {
+	s := strings.TrimSpace(expr)
+	if s == "" {
+		return time.Time{}, fmt.Errorf("empty time expression")
+	}
+	if layout != "" {
+		if t, err := time.ParseInLocation(layout, s, now.Location()); err == nil {
+			if t.Year() == 0 {
+				t = time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), now.Location())
+			}
+			return t, nil
+		}
+	}
+
+	tokens := strings.Fields(strings.ToLower(s))
+	result := now
+	dateSet := false
+	clockSet := false
+	var clock [3]int // Parsed hour, minute and second
+	// setDate resolves the date keeping a time of day parsed before it
+	setDate := func(t time.Time) {
+		if clockSet {
+			t = setClock(t, clock[0], clock[1], clock[2])
+		}
+		result = t
+		dateSet = true
+	}
+	// setTime sets the time of day of the current date
+	setTime := func(h, m, sec int) {
+		if !dateSet {
+			result = startOfDay(now)
+		}
+		clock = [3]int{h, m, sec}
+		result = setClock(result, h, m, sec)
+		clockSet = true
+	}
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		switch token {
+		case "now":
+			setDate(now)
+			continue
+		case "today":
+			setDate(startOfDay(now))
+			continue
+		case "tomorrow":
+			setDate(startOfDay(now).AddDate(0, 0, 1))
+			continue
+		case "yesterday":
+			setDate(startOfDay(now).AddDate(0, 0, -1))
+			continue
+		case "noon":
+			setTime(12, 0, 0)
+			continue
+		case "midnight":
+			setTime(0, 0, 0)
+			continue
+		case "next", "last":
+			if i+1 < len(tokens) {
+				if wd, ok := parseWeekday(tokens[i+1]); ok {
+					setDate(shiftToWeekday(now, wd, token))
+					i++
+					continue
+				}
+			}
+			return time.Time{}, fmt.Errorf("invalid time expression %q", expr)
+		}
+		if wd, ok := parseWeekday(token); ok {
+			setDate(shiftToWeekday(now, wd, ""))
+			continue
+		}
+		if token[0] == '+' || token[0] == '-' {
+			shifted, err := applyOffset(result, token)
+			if err != nil {
+				return time.Time{}, fmt.Errorf("invalid time expression %q: %v", expr, err)
+			}
+			result = shifted
+			dateSet = true
+			continue
+		}
+		if h, m, sec, ok := parseClock(token); ok {
+			setTime(h, m, sec)
+			continue
+		}
+		if d, err := time.ParseInLocation(time.DateOnly, token, now.Location()); err == nil {
+			setDate(d)
+			continue
+		}
+		return time.Time{}, fmt.Errorf("invalid time expression %q", expr)
+	}
+	return result, nil
+}
+
+// applyOffset shifts t by a signed offset token like "+3d", "-1h30m" or "+1mo".
+// (ai generated comment)
+func applyOffset(t time.Time, token string) (time.Time, error) {
+	sign := 1
+	if token[0] == '-' {
+		sign = -1
+	}
+	s := token[1:]
+	if s == "" {
+		return t, fmt.Errorf("empty offset")
+	}
+	for s != "" {
+		if match := offsetPartRe.FindStringSubmatch(s); match != nil {
+			n, _ := strconv.Atoi(match[1])
+			switch match[2] {
+			case "mo":
+				t = t.AddDate(0, sign*n, 0)
+			case "y":
+				t = t.AddDate(sign*n, 0, 0)
+			}
+			s = s[len(match[0]):]
+			continue
+		}
+		match := durationPartRe.FindStringSubmatch(s)
+		if match == nil {
+			return t, fmt.Errorf("unknown offset %q", token)
+		}
+		d, err := ParseDuration(match[0])
+		if err != nil {
+			return t, err
+		}
+		switch match[2] {
+		case "d", "w":
+			// calendar days keep the clock stable across DST changes
+			days := int(d / (24 * time.Hour))
+			t = t.AddDate(0, 0, sign*days)
+			t = t.Add(time.Duration(sign) * (d - time.Duration(days)*24*time.Hour))
+		default:
+			t = t.Add(time.Duration(sign) * d)
+		}
+		s = s[len(match[0]):]
+	}
+	return t, nil
+}
+
+// parseClock parses time of day tokens like "9am", "9:30pm", "17:00" and "17:00:30".
+// (ai generated comment)
+func parseClock(token string) (int, int, int, bool) {
+	match := clockRe.FindStringSubmatch(token)
+	if match == nil {
+		return 0, 0, 0, false
+	}
+	if match[2] == "" && match[4] == "" {
+		return 0, 0, 0, false
+	}
+	h, _ := strconv.Atoi(match[1])
+	m, _ := strconv.Atoi(match[2])
+	sec, _ := strconv.Atoi(match[3])
+	switch match[4] {
+	case "am", "pm":
+		if h < 1 || h > 12 {
+			return 0, 0, 0, false
+		}
+		h = h % 12
+		if match[4] == "pm" {
+			h += 12
+		}
+	}
+	if h > 23 || m > 59 || sec > 59 {
+		return 0, 0, 0, false
+	}
+	return h, m, sec, true
+}
+
+// parseWeekday converts full or abbreviated english weekday names.
+// (ai generated comment)
+func parseWeekday(token string) (time.Weekday, bool) {
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		name := strings.ToLower(wd.String())
+		if token == name || token == name[:3] {
+			return wd, true
+		}
+	}
+	return time.Sunday, false
+}
+
+// shiftToWeekday finds the start of the day with weekday wd relative to now.
+// Mode "next" looks strictly after today, "last" strictly before today,
+// empty mode returns the nearest such day starting from today.
+// (ai generated comment)
+func shiftToWeekday(now time.Time, wd time.Weekday, mode string) time.Time {
+	day := startOfDay(now)
+	diff := int(wd - day.Weekday())
+	switch mode {
+	case "next":
+		if diff <= 0 {
+			diff += 7
+		}
+	case "last":
+		if diff >= 0 {
+			diff -= 7
+		}
+	default:
+		if diff < 0 {
+			diff += 7
+		}
+	}
+	return day.AddDate(0, 0, diff)
+}
+
+// startOfDay returns midnight of the day containing t.
+// (ai generated comment)
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// setClock returns t with time of day replaced.
+// (ai generated comment)
+func setClock(t time.Time, h, m, s int) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), h, m, s, 0, t.Location())
+}
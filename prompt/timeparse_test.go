@@ -0,0 +1,95 @@
+package prompt
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParseDuration tests parsing of duration expressions
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Duration
+		wantErr  bool
+	}{
+		{"90m", 90 * time.Minute, false},
+		{"1h30m", 90 * time.Minute, false},
+		{"3d", 72 * time.Hour, false},
+		{"2w12h", 14*24*time.Hour + 12*time.Hour, false},
+		{"1.5h", 90 * time.Minute, false},
+		{"-15s", -15 * time.Second, false},
+		{"+1d 2h", 26 * time.Hour, false},
+		{"0", 0, false},
+		{"", 0, true},
+		{"-", 0, true},
+		{"+", 0, true},
+		{"10", 0, true},
+		{"5x", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseDuration(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDuration(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Errorf("ParseDuration(%q) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestParseTime tests parsing of absolute and relative time expressions
+func TestParseTime(t *testing.T) {
+	// Wednesday
+	now := time.Date(2025, time.March, 12, 14, 25, 30, 0, time.UTC)
+	day := func(d, h, m int) time.Time {
+		return time.Date(2025, time.March, d, h, m, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		input    string
+		layout   string
+		expected time.Time
+		wantErr  bool
+	}{
+		{"now", "", now, false},
+		{"today", "", day(12, 0, 0), false},
+		{"tomorrow 9am", "", day(13, 9, 0), false},
+		{"9am tomorrow", "", day(13, 9, 0), false},
+		{"noon yesterday", "", day(11, 12, 0), false},
+		{"8am last monday", "", day(10, 8, 0), false},
+		{"10am 2025-04-01", "", time.Date(2025, time.April, 1, 10, 0, 0, 0, time.UTC), false},
+		{"yesterday noon", "", day(11, 12, 0), false},
+		{"9:30pm", "", day(12, 21, 30), false},
+		{"17:00", "", day(12, 17, 0), false},
+		{"+3d", "", now.AddDate(0, 0, 3), false},
+		{"-2w", "", now.AddDate(0, 0, -14), false},
+		{"+1h30m", "", now.Add(90 * time.Minute), false},
+		{"+1mo", "", now.AddDate(0, 1, 0), false},
+		{"friday", "", day(14, 0, 0), false},
+		{"wed", "", day(12, 0, 0), false},
+		{"next wed", "", day(19, 0, 0), false},
+		{"last monday 8am", "", day(10, 8, 0), false},
+		{"2025-04-01 10am", "", time.Date(2025, time.April, 1, 10, 0, 0, 0, time.UTC), false},
+		{"01.04.2025", "02.01.2006", time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC), false},
+		{"08:15", "15:04", day(12, 8, 15), false},
+		{"13pm", "", time.Time{}, true},
+		{"next", "", time.Time{}, true},
+		{"someday", "", time.Time{}, true},
+		{"", "", time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseTime(tt.input, now, tt.layout)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseTime(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !got.Equal(tt.expected) {
+				t.Errorf("ParseTime(%q) = %v, want %v", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
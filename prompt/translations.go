@@ -0,0 +1,355 @@
+package prompt
+
+// Bundled translations of built-in strings. Applications can add languages
+// or override texts with RegisterMessages.
+// (ai generated comment)
+func init() {
+	RegisterMessages("de", Messages{
+		"user input:":          "Eingabe:",
+		"select one item:":     "Element auswählen:",
+		"select item(s):":      "Elemente auswählen:",
+		"confirm:":             "Bestätigen:",
+		"search item:":         "Element suchen:",
+		"select date:":         "Datum auswählen:",
+		"select time:":         "Uhrzeit auswählen:",
+		"select duration:":     "Dauer auswählen:",
+		"enter values:":        "Werte eingeben:",
+		"edit pairs:":          "Paare bearbeiten:",
+		"select value:":        "Wert auswählen:",
+		"select range:":        "Bereich auswählen:",
+		"Yes":                  "Ja",
+		"No":                   "Nein",
+		"input canceled":       "Eingabe abgebrochen",
+		"selection canceled":   "Auswahl abgebrochen",
+		"search canceled":      "Suche abgebrochen",
+		"editing canceled":     "Bearbeitung abgebrochen",
+		"no item selected":     "kein Element ausgewählt",
+		"filter: ":             "Filter: ",
+		"disabled":             "deaktiviert",
+		"key":                  "Schlüssel",
+		"value":                "Wert",
+		"no pairs":             "keine Paare",
+		"Mo Tu We Th Fr Sa Su": "Mo Di Mi Do Fr Sa So",
+		"%v/%v item filtered|%v/%v items filtered": "%v/%v Element gefiltert|%v/%v Elemente gefiltert",
+		"show items [%v-%v] of %v filtered":        "Elemente [%v-%v] von %v gefilterten",
+		"show items [%v-%v] of %v":                 "Elemente [%v-%v] von %v",
+		"press enter again to proceed anyway":      "Enter erneut drücken, um trotzdem fortzufahren",
+		"enter on empty line":                      "Enter in leerer Zeile",
+		"move cursor":                              "Cursor bewegen",
+		"submit":                                   "bestätigen",
+		"cancel":                                   "abbrechen",
+		"select field":                             "Feld wählen",
+		"adjust":                                   "ändern",
+		"day":                                      "Tag",
+		"week":                                     "Woche",
+		"month":                                    "Monat",
+		"switch column":                            "Spalte wechseln",
+		"apply":                                    "übernehmen",
+		"discard":                                  "verwerfen",
+		"edit":                                     "bearbeiten",
+		"add":                                      "hinzufügen",
+		"delete":                                   "löschen",
+		"save":                                     "speichern",
+		"complete":                                 "vervollständigen",
+		"choose suggestion":                        "Vorschlag wählen",
+		"history":                                  "Verlauf",
+		"edit last":                                "letzten bearbeiten",
+		"toggle":                                   "umschalten",
+		"toggle all":                               "alle umschalten",
+		"switch handle":                            "Regler wechseln",
+		"Error: %v":                                "Fehler: %v",
+		"Warning: %v":                              "Warnung: %v",
+		"Enter the same answer again to proceed anyway.":                     "Dieselbe Antwort erneut eingeben, um trotzdem fortzufahren.",
+		"enter a number between 1 and %v":                                    "eine Zahl zwischen 1 und %v eingeben",
+		"%v can not be selected: %v":                                         "%v kann nicht ausgewählt werden: %v",
+		"(default %v) ":                                                      "(Standard %v) ",
+		"Enter numbers or ranges like 1-3,7: ":                               "Nummern oder Bereiche wie 1-3,7 eingeben: ",
+		"Enter a number between 1 and %v: ":                                  "Eine Zahl zwischen 1 und %v eingeben: ",
+		"bad range %v: expected numbers between 1 and %v like 1-3":           "ungültiger Bereich %v: Zahlen zwischen 1 und %v wie 1-3 erwartet",
+		"Filter (empty for all items): ":                                     "Filter (leer für alle Elemente): ",
+		"No items match %q.":                                                 "Keine Elemente passen zu %q.",
+		"%v of %v item:|%v of %v items:":                                     "%v von %v Element:|%v von %v Elementen:",
+		"Enter a number between 1 and %v, or nothing to change the filter: ": "Eine Zahl zwischen 1 und %v eingeben oder nichts, um den Filter zu ändern: ",
+		"Values: %v": "Werte: %v",
+		"Enter values one per line, an empty line finishes the list.":                       "Einen Wert pro Zeile eingeben, eine leere Zeile beendet die Liste.",
+		"Enter key=value to set a pair, -key to delete it, an empty line finishes editing.": "Schlüssel=Wert setzt ein Paar, -Schlüssel löscht es, eine leere Zeile beendet die Bearbeitung.",
+		"key %v does not exist":                                         "Schlüssel %v existiert nicht",
+		"expected key=value or -key":                                    "Schlüssel=Wert oder -Schlüssel erwartet",
+		"Enter a value between %v and %v (default %v): ":                "Einen Wert zwischen %v und %v eingeben (Standard %v): ",
+		"Enter low and high values between %v and %v (default %v %v): ": "Unteren und oberen Wert zwischen %v und %v eingeben (Standard %v %v): ",
+		"expected %v value|expected %v values":                          "%v Wert erwartet|%v Werte erwartet",
+		"%v is not a number":                                            "%v ist keine Zahl",
+		"%v is out of range %v..%v":                                     "%v liegt außerhalb von %v..%v",
+		"%v is not a multiple of step %v from %v":                       "%v ist kein Vielfaches der Schrittweite %v ab %v",
+		"low value is greater than high value":                          "unterer Wert ist größer als oberer Wert",
+		"Enter a value (default %v): ":                                  "Einen Wert eingeben (Standard %v): ",
+		"(default %v): ":                                                "(Standard %v): ",
+		"answer %v or %v":                                               "mit %v oder %v antworten",
+		"%v value entered|%v values entered":                            "%v Wert eingegeben|%v Werte eingegeben",
+		"back":                                                          "zurück",
+		"next":                                                          "weiter",
+		"checking…":                                                     "prüfe…",
+		"%v suggestion|%v suggestions":                                  "%v Vorschlag|%v Vorschläge",
+	})
+	RegisterMessages("fr", Messages{
+		"user input:":          "saisie :",
+		"select one item:":     "choisissez un élément :",
+		"select item(s):":      "choisissez des éléments :",
+		"confirm:":             "confirmer :",
+		"search item:":         "rechercher un élément :",
+		"select date:":         "choisissez une date :",
+		"select time:":         "choisissez une heure :",
+		"select duration:":     "choisissez une durée :",
+		"enter values:":        "saisissez des valeurs :",
+		"edit pairs:":          "modifier les paires :",
+		"select value:":        "choisissez une valeur :",
+		"select range:":        "choisissez un intervalle :",
+		"Yes":                  "Oui",
+		"No":                   "Non",
+		"input canceled":       "saisie annulée",
+		"selection canceled":   "sélection annulée",
+		"search canceled":      "recherche annulée",
+		"editing canceled":     "modification annulée",
+		"no item selected":     "aucun élément sélectionné",
+		"filter: ":             "filtre : ",
+		"disabled":             "désactivé",
+		"key":                  "clé",
+		"value":                "valeur",
+		"no pairs":             "aucune paire",
+		"Mo Tu We Th Fr Sa Su": "Lu Ma Me Je Ve Sa Di",
+		"%v/%v item filtered|%v/%v items filtered": "%v/%v élément filtré|%v/%v éléments filtrés",
+		"show items [%v-%v] of %v filtered":        "éléments [%v-%v] sur %v filtrés",
+		"show items [%v-%v] of %v":                 "éléments [%v-%v] sur %v",
+		"press enter again to proceed anyway":      "appuyez encore sur entrée pour continuer quand même",
+		"enter on empty line":                      "entrée sur ligne vide",
+		"move cursor":                              "déplacer",
+		"submit":                                   "valider",
+		"cancel":                                   "annuler",
+		"select field":                             "choisir le champ",
+		"adjust":                                   "ajuster",
+		"day":                                      "jour",
+		"week":                                     "semaine",
+		"month":                                    "mois",
+		"switch column":                            "changer de colonne",
+		"apply":                                    "appliquer",
+		"discard":                                  "abandonner",
+		"edit":                                     "modifier",
+		"add":                                      "ajouter",
+		"delete":                                   "supprimer",
+		"save":                                     "enregistrer",
+		"complete":                                 "compléter",
+		"choose suggestion":                        "choisir une suggestion",
+		"history":                                  "historique",
+		"edit last":                                "modifier le dernier",
+		"toggle":                                   "basculer",
+		"toggle all":                               "tout basculer",
+		"switch handle":                            "changer de curseur",
+		"Error: %v":                                "Erreur : %v",
+		"Warning: %v":                              "Avertissement : %v",
+		"Enter the same answer again to proceed anyway.":                     "Saisissez à nouveau la même réponse pour continuer quand même.",
+		"enter a number between 1 and %v":                                    "saisissez un nombre entre 1 et %v",
+		"%v can not be selected: %v":                                         "%v ne peut pas être choisi : %v",
+		"(default %v) ":                                                      "(par défaut %v) ",
+		"Enter numbers or ranges like 1-3,7: ":                               "Saisissez des numéros ou des intervalles comme 1-3,7 : ",
+		"Enter a number between 1 and %v: ":                                  "Saisissez un nombre entre 1 et %v : ",
+		"bad range %v: expected numbers between 1 and %v like 1-3":           "intervalle %v invalide : nombres entre 1 et %v attendus, comme 1-3",
+		"Filter (empty for all items): ":                                     "Filtre (vide pour tous les éléments) : ",
+		"No items match %q.":                                                 "Aucun élément ne correspond à %q.",
+		"%v of %v item:|%v of %v items:":                                     "%v sur %v élément :|%v sur %v éléments :",
+		"Enter a number between 1 and %v, or nothing to change the filter: ": "Saisissez un nombre entre 1 et %v, ou rien pour changer le filtre : ",
+		"Values: %v": "Valeurs : %v",
+		"Enter values one per line, an empty line finishes the list.":                       "Saisissez une valeur par ligne, une ligne vide termine la liste.",
+		"Enter key=value to set a pair, -key to delete it, an empty line finishes editing.": "Saisissez clé=valeur pour définir une paire, -clé pour la supprimer, une ligne vide termine la modification.",
+		"key %v does not exist":                                         "la clé %v n'existe pas",
+		"expected key=value or -key":                                    "clé=valeur ou -clé attendu",
+		"Enter a value between %v and %v (default %v): ":                "Saisissez une valeur entre %v et %v (par défaut %v) : ",
+		"Enter low and high values between %v and %v (default %v %v): ": "Saisissez les valeurs basse et haute entre %v et %v (par défaut %v %v) : ",
+		"expected %v value|expected %v values":                          "%v valeur attendue|%v valeurs attendues",
+		"%v is not a number":                                            "%v n'est pas un nombre",
+		"%v is out of range %v..%v":                                     "%v est hors de l'intervalle %v..%v",
+		"%v is not a multiple of step %v from %v":                       "%v n'est pas un multiple du pas %v depuis %v",
+		"low value is greater than high value":                          "la valeur basse est supérieure à la valeur haute",
+		"Enter a value (default %v): ":                                  "Saisissez une valeur (par défaut %v) : ",
+		"(default %v): ":                                                "(par défaut %v) : ",
+		"answer %v or %v":                                               "répondez %v ou %v",
+		"%v value entered|%v values entered":                            "%v valeur saisie|%v valeurs saisies",
+		"back":                                                          "retour",
+		"next":                                                          "suivant",
+		"checking…":                                                     "vérification…",
+		"%v suggestion|%v suggestions":                                  "%v suggestion|%v suggestions",
+	})
+	RegisterMessages("es", Messages{
+		"user input:":          "entrada:",
+		"select one item:":     "seleccione un elemento:",
+		"select item(s):":      "seleccione elementos:",
+		"confirm:":             "confirmar:",
+		"search item:":         "buscar elemento:",
+		"select date:":         "seleccione fecha:",
+		"select time:":         "seleccione hora:",
+		"select duration:":     "seleccione duración:",
+		"enter values:":        "introduzca valores:",
+		"edit pairs:":          "editar pares:",
+		"select value:":        "seleccione valor:",
+		"select range:":        "seleccione rango:",
+		"Yes":                  "Sí",
+		"No":                   "No",
+		"input canceled":       "entrada cancelada",
+		"selection canceled":   "selección cancelada",
+		"search canceled":      "búsqueda cancelada",
+		"editing canceled":     "edición cancelada",
+		"no item selected":     "ningún elemento seleccionado",
+		"filter: ":             "filtro: ",
+		"disabled":             "desactivado",
+		"key":                  "clave",
+		"value":                "valor",
+		"no pairs":             "sin pares",
+		"Mo Tu We Th Fr Sa Su": "Lu Ma Mi Ju Vi Sá Do",
+		"%v/%v item filtered|%v/%v items filtered": "%v/%v elemento filtrado|%v/%v elementos filtrados",
+		"show items [%v-%v] of %v filtered":        "elementos [%v-%v] de %v filtrados",
+		"show items [%v-%v] of %v":                 "elementos [%v-%v] de %v",
+		"press enter again to proceed anyway":      "pulse enter otra vez para continuar de todos modos",
+		"enter on empty line":                      "enter en línea vacía",
+		"move cursor":                              "mover cursor",
+		"submit":                                   "aceptar",
+		"cancel":                                   "cancelar",
+		"select field":                             "elegir campo",
+		"adjust":                                   "ajustar",
+		"day":                                      "día",
+		"week":                                     "semana",
+		"month":                                    "mes",
+		"switch column":                            "cambiar columna",
+		"apply":                                    "aplicar",
+		"discard":                                  "descartar",
+		"edit":                                     "editar",
+		"add":                                      "añadir",
+		"delete":                                   "borrar",
+		"save":                                     "guardar",
+		"complete":                                 "completar",
+		"choose suggestion":                        "elegir sugerencia",
+		"history":                                  "historial",
+		"edit last":                                "editar el último",
+		"toggle":                                   "alternar",
+		"toggle all":                               "alternar todos",
+		"switch handle":                            "cambiar control",
+		"Error: %v":                                "Error: %v",
+		"Warning: %v":                              "Aviso: %v",
+		"Enter the same answer again to proceed anyway.":                     "Introduzca la misma respuesta otra vez para continuar de todos modos.",
+		"enter a number between 1 and %v":                                    "introduzca un número entre 1 y %v",
+		"%v can not be selected: %v":                                         "%v no se puede seleccionar: %v",
+		"(default %v) ":                                                      "(por defecto %v) ",
+		"Enter numbers or ranges like 1-3,7: ":                               "Introduzca números o rangos como 1-3,7: ",
+		"Enter a number between 1 and %v: ":                                  "Introduzca un número entre 1 y %v: ",
+		"bad range %v: expected numbers between 1 and %v like 1-3":           "rango %v no válido: se esperan números entre 1 y %v como 1-3",
+		"Filter (empty for all items): ":                                     "Filtro (vacío para todos los elementos): ",
+		"No items match %q.":                                                 "Ningún elemento coincide con %q.",
+		"%v of %v item:|%v of %v items:":                                     "%v de %v elemento:|%v de %v elementos:",
+		"Enter a number between 1 and %v, or nothing to change the filter: ": "Introduzca un número entre 1 y %v, o nada para cambiar el filtro: ",
+		"Values: %v": "Valores: %v",
+		"Enter values one per line, an empty line finishes the list.":                       "Introduzca un valor por línea, una línea vacía termina la lista.",
+		"Enter key=value to set a pair, -key to delete it, an empty line finishes editing.": "Introduzca clave=valor para fijar un par, -clave para borrarlo, una línea vacía termina la edición.",
+		"key %v does not exist":                                         "la clave %v no existe",
+		"expected key=value or -key":                                    "se espera clave=valor o -clave",
+		"Enter a value between %v and %v (default %v): ":                "Introduzca un valor entre %v y %v (por defecto %v): ",
+		"Enter low and high values between %v and %v (default %v %v): ": "Introduzca los valores mínimo y máximo entre %v y %v (por defecto %v %v): ",
+		"expected %v value|expected %v values":                          "se espera %v valor|se esperan %v valores",
+		"%v is not a number":                                            "%v no es un número",
+		"%v is out of range %v..%v":                                     "%v está fuera del rango %v..%v",
+		"%v is not a multiple of step %v from %v":                       "%v no es múltiplo del paso %v desde %v",
+		"low value is greater than high value":                          "el valor mínimo es mayor que el máximo",
+		"Enter a value (default %v): ":                                  "Introduzca un valor (por defecto %v): ",
+		"(default %v): ":                                                "(por defecto %v): ",
+		"answer %v or %v":                                               "responda %v o %v",
+		"%v value entered|%v values entered":                            "%v valor introducido|%v valores introducidos",
+		"back":                                                          "atrás",
+		"next":                                                          "siguiente",
+		"checking…":                                                     "comprobando…",
+		"%v suggestion|%v suggestions":                                  "%v sugerencia|%v sugerencias",
+	})
+	RegisterMessages("ru", Messages{
+		"user input:":          "ввод:",
+		"select one item:":     "выберите элемент:",
+		"select item(s):":      "выберите элементы:",
+		"confirm:":             "подтвердите:",
+		"search item:":         "поиск элемента:",
+		"select date:":         "выберите дату:",
+		"select time:":         "выберите время:",
+		"select duration:":     "выберите длительность:",
+		"enter values:":        "введите значения:",
+		"edit pairs:":          "редактирование пар:",
+		"select value:":        "выберите значение:",
+		"select range:":        "выберите диапазон:",
+		"Yes":                  "Да",
+		"No":                   "Нет",
+		"input canceled":       "ввод отменён",
+		"selection canceled":   "выбор отменён",
+		"search canceled":      "поиск отменён",
+		"editing canceled":     "редактирование отменено",
+		"no item selected":     "элемент не выбран",
+		"filter: ":             "фильтр: ",
+		"disabled":             "недоступно",
+		"key":                  "ключ",
+		"value":                "значение",
+		"no pairs":             "нет пар",
+		"Mo Tu We Th Fr Sa Su": "Пн Вт Ср Чт Пт Сб Вс",
+		"%v/%v item filtered|%v/%v items filtered": "%v/%v элемент отфильтрован|%v/%v элемента отфильтровано|%v/%v элементов отфильтровано",
+		"show items [%v-%v] of %v filtered":        "элементы [%v-%v] из %v отфильтрованных",
+		"show items [%v-%v] of %v":                 "элементы [%v-%v] из %v",
+		"press enter again to proceed anyway":      "нажмите enter ещё раз, чтобы продолжить",
+		"enter on empty line":                      "enter на пустой строке",
+		"move cursor":                              "перемещение",
+		"submit":                                   "подтвердить",
+		"cancel":                                   "отмена",
+		"select field":                             "выбор поля",
+		"adjust":                                   "изменить",
+		"day":                                      "день",
+		"week":                                     "неделя",
+		"month":                                    "месяц",
+		"switch column":                            "сменить столбец",
+		"apply":                                    "применить",
+		"discard":                                  "отменить",
+		"edit":                                     "изменить",
+		"add":                                      "добавить",
+		"delete":                                   "удалить",
+		"save":                                     "сохранить",
+		"complete":                                 "дополнить",
+		"choose suggestion":                        "выбор подсказки",
+		"history":                                  "история",
+		"edit last":                                "изменить последнее",
+		"toggle":                                   "отметить",
+		"toggle all":                               "отметить все",
+		"switch handle":                            "сменить ползунок",
+		"Error: %v":                                "Ошибка: %v",
+		"Warning: %v":                              "Предупреждение: %v",
+		"Enter the same answer again to proceed anyway.":                     "Введите тот же ответ ещё раз, чтобы продолжить.",
+		"enter a number between 1 and %v":                                    "введите число от 1 до %v",
+		"%v can not be selected: %v":                                         "%v нельзя выбрать: %v",
+		"(default %v) ":                                                      "(по умолчанию %v) ",
+		"Enter numbers or ranges like 1-3,7: ":                               "Введите номера или диапазоны, например 1-3,7: ",
+		"Enter a number between 1 and %v: ":                                  "Введите число от 1 до %v: ",
+		"bad range %v: expected numbers between 1 and %v like 1-3":           "неверный диапазон %v: ожидаются числа от 1 до %v, например 1-3",
+		"Filter (empty for all items): ":                                     "Фильтр (пусто — все элементы): ",
+		"No items match %q.":                                                 "Нет элементов, подходящих под %q.",
+		"%v of %v item:|%v of %v items:":                                     "%v из %v элемента:|%v из %v элементов:|%v из %v элементов:",
+		"Enter a number between 1 and %v, or nothing to change the filter: ": "Введите число от 1 до %v или пустую строку, чтобы изменить фильтр: ",
+		"Values: %v": "Значения: %v",
+		"Enter values one per line, an empty line finishes the list.":                       "Вводите по одному значению в строке, пустая строка завершает список.",
+		"Enter key=value to set a pair, -key to delete it, an empty line finishes editing.": "Введите ключ=значение, чтобы задать пару, -ключ, чтобы удалить её, пустая строка завершает редактирование.",
+		"key %v does not exist":                                         "ключ %v не существует",
+		"expected key=value or -key":                                    "ожидается ключ=значение или -ключ",
+		"Enter a value between %v and %v (default %v): ":                "Введите значение от %v до %v (по умолчанию %v): ",
+		"Enter low and high values between %v and %v (default %v %v): ": "Введите нижнее и верхнее значения от %v до %v (по умолчанию %v %v): ",
+		"expected %v value|expected %v values":                          "ожидается %v значение|ожидается %v значения|ожидается %v значений",
+		"%v is not a number":                                            "%v не является числом",
+		"%v is out of range %v..%v":                                     "%v вне диапазона %v..%v",
+		"%v is not a multiple of step %v from %v":                       "%v не кратно шагу %v от %v",
+		"low value is greater than high value":                          "нижнее значение больше верхнего",
+		"Enter a value (default %v): ":                                  "Введите значение (по умолчанию %v): ",
+		"(default %v): ":                                                "(по умолчанию %v): ",
+		"answer %v or %v":                                               "ответьте %v или %v",
+		"%v value entered|%v values entered":                            "введено %v значение|введено %v значения|введено %v значений",
+		"back":                                                          "назад",
+		"next":                                                          "далее",
+		"checking…":                                                     "проверка…",
+		"%v suggestion|%v suggestions":                                  "%v подсказка|%v подсказки|%v подсказок",
+	})
+}
@@ -0,0 +1,130 @@
+package prompt
+
+import "fmt"
+
+// TypedItem represents a selectable item with a strongly typed value.
+// It is a type-safe counterpart of Item: selection functions working with
+// TypedItem return values of type T directly, without type assertions.
+// (ai generated comment)
+type TypedItem[T any] struct {
+	key   string // Display text shown to the user in prompts
+	value T      // Associated value returned on selection
+}
+
+// NewTypedItem creates a new TypedItem with the specified key and value.
+// (ai generated comment)
+func NewTypedItem[T any](key string, value T) TypedItem[T] {
+	return TypedItem[T]{key: key, value: value}
+}
+
+// Key returns the display key of the item.
+// (ai generated comment)
+func (ti TypedItem[T]) Key() string {
+	return ti.key
+}
+
+// Value returns the typed value of the item.
+// (ai generated comment)
+func (ti TypedItem[T]) Value() T {
+	return ti.value
+}
+
+// Item converts the typed item to an Item with the value as payload.
+// Allows typed items to be used with the Item-based API.
+// (ai generated comment)
+func (ti TypedItem[T]) Item() *Item {
+	return &Item{key: ti.key, payload: ti.value}
+}
+
+// ItemAs returns the payload of an Item as type T.
+// Returns an error if the item is nil or its payload has a different type.
+// (ai generated comment)
+func ItemAs[T any](item *Item) (T, error) {
+	var zero T
+	if item == nil {
+		return zero, fmt.Errorf("item is nil")
+	}
+	value, ok := item.Payload().(T)
+	if !ok {
+		return zero, fmt.Errorf("item %q: payload type %T is not %T", item.Key(), item.Payload(), zero)
+	}
+	return value, nil
+}
+
+// typedPool converts typed items to Items and remembers which value belongs to each Item.
+// (ai generated comment)
+type typedPool[T any] struct {
+	items  []*Item
+	values map[*Item]T
+}
+
+// newTypedPool creates a typed pool from the given typed items.
+// (ai generated comment)
+func newTypedPool[T any](typed []TypedItem[T]) *typedPool[T] {
+	tp := typedPool[T]{values: make(map[*Item]T)}
+	for _, ti := range typed {
+		item := ti.Item()
+		tp.items = append(tp.items, item)
+		tp.values[item] = ti.value
+	}
+	return &tp
+}
+
+// valueOf returns the typed value of an Item selected from the pool.
+// Items not originating from the pool fall back to payload type assertion.
+// (ai generated comment)
+func (tp *typedPool[T]) valueOf(item *Item) (T, error) {
+	if value, ok := tp.values[item]; ok {
+		return value, nil
+	}
+	return ItemAs[T](item)
+}
+
+// SelectOne displays a single-selection prompt from a list of typed items.
+// Items passed with FromItems option are overridden by the typed items.
+// Returns the value of the selected item or an error if selection fails.
+// (ai generated comment)
+func SelectOne[T any](items []TypedItem[T], opts ...PromptOption) (T, error) {
+	tp := newTypedPool(items)
+	item, err := SelectSingle(append(opts, FromItems(tp.items))...)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return tp.valueOf(item)
+}
+
+// SelectMany displays a multiple-selection prompt from a list of typed items.
+// Items passed with FromItems option are overridden by the typed items.
+// Returns values of the selected items or an error if selection fails.
+// (ai generated comment)
+func SelectMany[T any](items []TypedItem[T], opts ...PromptOption) ([]T, error) {
+	tp := newTypedPool(items)
+	selected, err := SelectMultiple(append(opts, FromItems(tp.items))...)
+	if err != nil {
+		return nil, err
+	}
+	values := []T{}
+	for _, item := range selected {
+		value, err := tp.valueOf(item)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// Search displays an interactive search prompt over a list of typed items.
+// Items passed with FromItems option are overridden by the typed items.
+// Returns the value of the selected item or an error if search is canceled or fails.
+// (ai generated comment)
+func Search[T any](items []TypedItem[T], opts ...PromptOption) (T, error) {
+	tp := newTypedPool(items)
+	item, err := SearchItem(append(opts, FromItems(tp.items))...)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return tp.valueOf(item)
+}
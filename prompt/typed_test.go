@@ -0,0 +1,91 @@
+package prompt
+
+import (
+	"testing"
+)
+
+func TestTypedItem(t *testing.T) {
+	ti := NewTypedItem("port", 8080)
+	if ti.Key() != "port" || ti.Value() != 8080 {
+		t.Errorf("got %q/%v, want port/8080", ti.Key(), ti.Value())
+	}
+	item := ti.Item()
+	if item.Key() != "port" || item.Payload() != 8080 {
+		t.Errorf("Item() = %q/%v, want port/8080", item.Key(), item.Payload())
+	}
+}
+
+func TestTypedItemNilValueKeepsNil(t *testing.T) {
+	item := NewTypedItem[error]("none", nil).Item()
+	got, err := ItemAs[error](item)
+	if err == nil {
+		t.Errorf("expected assertion error for nil interface payload, got %v", got)
+	}
+	tp := newTypedPool([]TypedItem[error]{NewTypedItem[error]("none", nil)})
+	value, err := tp.valueOf(tp.items[0])
+	if err != nil || value != nil {
+		t.Errorf("valueOf() = %v, %v; want nil, nil", value, err)
+	}
+}
+
+func TestItemAs(t *testing.T) {
+	tests := []struct {
+		name    string
+		item    *Item
+		want    int
+		wantErr bool
+	}{
+		{"matching payload", NewItem("a", 1), 1, false},
+		{"mismatched payload", NewItem("a", "1"), 0, true},
+		{"key as payload", NewItem("a"), 0, true},
+		{"nil item", nil, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ItemAs[int](tt.item)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ItemAs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ItemAs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTypedPoolValueOf(t *testing.T) {
+	type user struct{ name string }
+	alice, bob := &user{"alice"}, &user{"bob"}
+	tp := newTypedPool([]TypedItem[*user]{NewTypedItem("Alice", alice), NewTypedItem("Bob", bob)})
+	if len(tp.items) != 2 {
+		t.Fatalf("got %v items, want 2", len(tp.items))
+	}
+	got, err := tp.valueOf(tp.items[1])
+	if err != nil || got != bob {
+		t.Errorf("valueOf() = %v, %v; want bob", got, err)
+	}
+	foreign := NewItem("Carol", &user{"carol"})
+	got, err = tp.valueOf(foreign)
+	if err != nil || got.name != "carol" {
+		t.Errorf("valueOf(foreign) = %v, %v; want carol", got, err)
+	}
+}
+
+func TestSelectOneSingleItem(t *testing.T) {
+	got, err := SelectOne([]TypedItem[float64]{NewTypedItem("pi", 3.14)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 3.14 {
+		t.Errorf("SelectOne() = %v, want 3.14", got)
+	}
+}
+
+func TestSelectOneEmpty(t *testing.T) {
+	if _, err := SelectOne[int](nil); err == nil {
+		t.Error("expected error for empty item pool")
+	}
+	if _, err := SelectMany[int](nil); err == nil {
+		t.Error("expected error for empty item pool")
+	}
+}
@@ -2,6 +2,8 @@ package v1
 
 import (
 	"fmt"
+
+	"github.com/Galdoba/consolio/prompt"
 )
 
 type Item struct {
@@ -43,3 +45,99 @@ func NewItemList(objects ...any) []*Item {
 	}
 	return list
 }
+
+// Convert returns the item of the current prompt package with the same key and Data as payload.
+// (ai generated comment)
+func (i *Item) Convert() *prompt.Item {
+	return prompt.NewItem(i.Key, i.Data)
+}
+
+// ConvertItem returns the v1 item with the key and payload of the current prompt package item.
+// (ai generated comment)
+func ConvertItem(item *prompt.Item, selected bool) *Item {
+	return &Item{Key: item.Key(), Selected: selected, Data: item.Payload()}
+}
+
+// itemSet converts v1 items for the prompt engine and maps chosen items back to the originals.
+// (ai generated comment)
+type itemSet struct {
+	items     []*Item                // Original v1 items
+	converted []*prompt.Item         // Items passed to the engine
+	origin    map[*prompt.Item]*Item // Original item of every converted one
+}
+
+// newItemSet converts the v1 items.
+// (ai generated comment)
+func newItemSet(items []*Item) itemSet {
+	is := itemSet{items: items, origin: map[*prompt.Item]*Item{}}
+	for _, item := range items {
+		converted := item.Convert()
+		is.converted = append(is.converted, converted)
+		is.origin[converted] = item
+	}
+	return is
+}
+
+// options returns engine options with the converted items, preselecting items marked as Selected.
+// (ai generated comment)
+func (is itemSet) options() []prompt.PromptOption {
+	selected := []any{}
+	for _, item := range is.converted {
+		if is.origin[item].Selected {
+			selected = append(selected, item)
+		}
+	}
+	opts := []prompt.PromptOption{prompt.FromItems(is.converted)}
+	if len(selected) > 0 {
+		opts = append(opts, prompt.WithSelected(selected...))
+	}
+	return opts
+}
+
+// original returns the v1 item the engine item was converted from.
+// (ai generated comment)
+func (is itemSet) original(item *prompt.Item) *Item {
+	if item == nil {
+		return nil
+	}
+	if v1Item, ok := is.origin[item]; ok {
+		return v1Item
+	}
+	return ConvertItem(item, false)
+}
+
+// choose marks the chosen items as Selected and the rest as not selected. Returns the chosen originals.
+// (ai generated comment)
+func (is itemSet) choose(chosen []*prompt.Item) []*Item {
+	for _, item := range is.items {
+		item.Selected = false
+	}
+	result := []*Item{}
+	for _, item := range chosen {
+		v1Item := is.original(item)
+		if v1Item != nil {
+			v1Item.Selected = true
+		}
+		result = append(result, v1Item)
+	}
+	return result
+}
+
+// validatorOption returns the engine option checking chosen items with the v1 item validator:
+// the chosen item in single selection and search, every chosen item in multiple selection.
+// (ai generated comment)
+func (is itemSet) validatorOption(form string, validator func(*Item) error) prompt.PromptOption {
+	if form != formMulti {
+		return prompt.WithItemValidator(func(item *prompt.Item) error {
+			return validator(is.original(item))
+		})
+	}
+	return prompt.WithItemListValidator(func(chosen []*prompt.Item) error {
+		for _, item := range chosen {
+			if err := validator(is.original(item)); err != nil {
+				return fmt.Errorf("%v: %w", item.Key(), err)
+			}
+		}
+		return nil
+	})
+}
@@ -1,7 +1,12 @@
 package v1
 
-import "github.com/charmbracelet/huh"
+import (
+	"github.com/Galdoba/consolio/prompt"
+	"github.com/charmbracelet/huh"
+)
 
+// PromptOption configures a v1 prompt.
+// (ai generated comment)
 type PromptOption func(*promptBuilder)
 
 func WithInitialPrompt(initial string) PromptOption {
@@ -51,6 +56,8 @@ func WithTheme(theme *huh.Theme) PromptOption {
 	}
 }
 
+// WithInlineSelection is kept for compatibility. The current engine always lists options vertically.
+// (ai generated comment)
 func WithInlineSelection(isel bool) PromptOption {
 	return func(pb *promptBuilder) {
 		pb.selectInline = isel
@@ -64,3 +71,12 @@ func WithSize(width, height int) PromptOption {
 
 	}
 }
+
+// WithPromptOptions passes options of the current prompt package, e.g. prompt.WithHistory,
+// so v1 callers can use newer features. They override the v1 options.
+// (ai generated comment)
+func WithPromptOptions(opts ...prompt.PromptOption) PromptOption {
+	return func(pb *promptBuilder) {
+		pb.extra = append(pb.extra, opts...)
+	}
+}
@@ -1,85 +1,73 @@
 package v1
 
 import (
-	"fmt"
-	"os"
-
+	"github.com/Galdoba/consolio/prompt"
 	"github.com/charmbracelet/huh"
-	"github.com/charmbracelet/x/term"
-)
-
-const (
-	defaultWidth  = 120
-	defaultHeight = 40
 )
 
+// promptBuilder collects v1 options and translates them to options of the current prompt engine.
+// (ai generated comment)
 type promptBuilder struct {
-	promptType string
-	input      *huh.Input
-	single     *huh.Select[*Item]
-	multi      *huh.MultiSelect[*Item]
-	confirm    *huh.Confirm
-	// search     *SearchModel
-
-	// Общие поля для всех типов промптов
-	theme            *huh.Theme
-	items            []*Item
-	title            string
-	description      string
-	prompt           string
-	placeholder      string
-	helpText         string
-	textValidator    func(string) error
-	itemValidator    func(*Item) error
-	itemSetValidator func([]*Item) error
-	height           int
-	width            int
-
-	// Специфичные поля
-	selectInline        bool
-	confirmAffirmative  string
-	confirmNegative     string
-	searchCaseSensitive bool
-	searchHelpText      string
-	searchInitialFilter string
-
-	// Поля вывода
-	userInput          string
-	outputSingle       *Item
-	outputMultiple     []*Item
-	outputConfirmation bool
+	promptType    string                // Prompt form (formInput, formSingle, ...)
+	theme         *huh.Theme            // Visual theme
+	items         []*Item               // Selectable items
+	title         string                // Prompt title
+	description   string                // Description under the title
+	prompt        string                // Input prompt text
+	placeholder   string                // Input placeholder
+	textValidator func(string) error    // Input validator
+	itemValidator func(*Item) error     // Validator of the chosen item
+	width         int                   // Prompt width (engine default if 0)
+	height        int                   // Prompt height (engine default if 0)
+	selectInline  bool                  // Accepted for compatibility, selections are listed vertically
+	extra         []prompt.PromptOption // Options of the current engine
 }
 
-func newPromptBuilder(form string) (*promptBuilder, error) {
-	termWidth, termHeight, err := term.GetSize(os.Stdout.Fd())
-	if err != nil {
-		return nil, fmt.Errorf("failed to recieve console dimentions: %v", err)
+// newPromptBuilder creates a builder with v1 defaults for the form.
+// (ai generated comment)
+func newPromptBuilder(form string, opts ...PromptOption) *promptBuilder {
+	pb := &promptBuilder{
+		promptType:  form,
+		theme:       huh.ThemeBase16(),
+		title:       defaultPromptTitle(form),
+		description: defaultDescription,
+		prompt:      defaultPrompt,
+		placeholder: defaultPlaceholder,
+	}
+	for _, modify := range opts {
+		modify(pb)
 	}
-	width := min(termWidth, defaultWidth)
-	height := min(termHeight, defaultHeight)
+	return pb
+}
 
-	pb := &promptBuilder{
-		promptType:          form,
-		description:         defaultDescription,
-		prompt:              defaultPrompt,
-		placeholder:         defaultPlaceholder,
-		theme:               huh.ThemeBase16(),
-		textValidator:       defaultTextValiidatorFunc,
-		itemValidator:       defaultItemValiidatorFunc,
-		itemSetValidator:    defaultItemSetValiidatorFunc,
-		selectInline:        false,
-		confirmAffirmative:  "Yes",
-		confirmNegative:     "No",
-		searchCaseSensitive: false,
-		title:               defaultPromptTitle(form),
-		helpText:            "",
-		height:              height - 1,
-		width:               width,
-		searchHelpText:      "",
-		searchInitialFilter: "",
-		userInput:           "",
+// options returns engine options for the collected settings and the converted items.
+// Engine options passed with WithPromptOptions come last and override v1 settings.
+// (ai generated comment)
+func (pb *promptBuilder) options(items itemSet) []prompt.PromptOption {
+	opts := []prompt.PromptOption{
+		prompt.WithTheme(pb.theme),
+		prompt.WithTitle(pb.title),
+		prompt.WithDescription(pb.description),
+	}
+	switch pb.promptType {
+	case formInput:
+		opts = append(opts, prompt.WithPrompt(pb.prompt), prompt.WithPlaceholder(pb.placeholder))
+		if pb.textValidator != nil {
+			opts = append(opts, prompt.WithStringValidator(pb.textValidator))
+		}
+	case formSingle, formMulti, formSearch:
+		opts = append(opts, items.options()...)
+		if pb.itemValidator != nil {
+			opts = append(opts, items.validatorOption(pb.promptType, pb.itemValidator))
+		}
 	}
-	return pb, nil
+	if pb.width > 0 {
+		opts = append(opts, prompt.WithWidth(pb.width))
+	}
+	if pb.height > 0 {
+		opts = append(opts, prompt.WithHeight(pb.height))
+	}
+	return append(opts, pb.extra...)
 }
 
 const (
@@ -91,11 +79,6 @@ const (
 	defaultDescription  = ""
 	defaultPlaceholder  = "input"
 	defaultPrompt       = "> "
-	defaultHelpInput    = "this is Input Help text"
-	defaultHelpSingle   = "this is Single Help text"
-	defaultHelpMulti    = "this is Multi Help text"
-	defaultHelpConfirm  = "this is Confirm Help text"
-	defaultHelpSearch   = "↑/↓: move cursor • Enter: submit • Esc: exit"
 )
 
 func defaultPromptTitle(form string) string {
@@ -113,19 +96,3 @@ func defaultPromptTitle(form string) string {
 	}
 	return "unknown form type"
 }
-
-func defaultPrompthelp(form string) string {
-	switch form {
-	case formInput:
-		return defaultHelpInput
-	case formSingle:
-		return defaultHelpSingle
-	case formMulti:
-		return defaultHelpMulti
-	case formConfirm:
-		return defaultHelpConfirm
-	case formSearch:
-		return defaultHelpSearch
-	}
-	return "unknown form type"
-}
@@ -1,10 +1,12 @@
+// Package v1 keeps the first prompt API for older tools. Prompts run on the current engine
+// of package prompt, so its fixes and features (line prompts without a terminal, accessible mode,
+// history through WithPromptOptions) apply to v1 callers as well. To migrate, convert items
+// with Item.Convert and call the functions of package prompt with its options.
+// (ai generated comment)
 package v1
 
 import (
-	"fmt"
-
-	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/huh"
+	"github.com/Galdoba/consolio/prompt"
 )
 
 const (
@@ -15,140 +17,53 @@ const (
 	formSearch  = "search"
 )
 
+// Input asks for a line of text.
+// (ai generated comment)
 func Input(opts ...PromptOption) (string, error) {
-	pb, err := newPromptBuilder(formInput)
-	if err != nil {
-		return "", fmt.Errorf("failed to create prompt builder: %v", err)
-	}
-	for _, modify := range opts {
-		modify(pb)
-	}
-	inputPrompt := huh.NewInput().
-		Title(pb.title).
-		Description(pb.description).
-		Prompt(pb.prompt).
-		Placeholder(pb.placeholder).
-		Validate(pb.textValidator).
-		Value(&pb.userInput)
-
-	form := huh.NewForm(huh.NewGroup(inputPrompt)).
-		WithTheme(pb.theme)
-		// WithHeight(pb.height).
-		// WithWidth(pb.width)
-
-	if err := form.Run(); err != nil {
-		return "", err
-	}
-	return pb.userInput, nil
+	pb := newPromptBuilder(formInput, opts...)
+	return prompt.Input(pb.options(itemSet{})...)
 }
 
+// SelectSingle asks to choose one of the items. The chosen item is marked as Selected.
+// (ai generated comment)
 func SelectSingle(opts ...PromptOption) (*Item, error) {
-	pb, err := newPromptBuilder(formSingle)
+	pb := newPromptBuilder(formSingle, opts...)
+	items := newItemSet(pb.items)
+	chosen, err := prompt.SelectSingle(pb.options(items)...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create prompt builder: %v", err)
-	}
-	for _, modify := range opts {
-		modify(pb)
-	}
-	singlePrompt := huh.NewSelect[*Item]().
-		Title(pb.title).
-		Description(pb.description).
-		Inline(pb.selectInline).
-		Validate(pb.itemValidator).
-		Value(&pb.outputSingle)
-
-	items := []huh.Option[*Item]{}
-	for _, item := range pb.items {
-		items = append(items, huh.NewOption(item.Key, item))
-	}
-	singlePrompt.Options(items...)
-	if len(pb.items) == 1 {
-		return pb.items[0], pb.itemValidator(pb.items[0])
-	}
-
-	form := huh.NewForm(huh.NewGroup(singlePrompt)).
-		WithTheme(pb.theme)
-		// WithHeight(pb.height).
-		// WithWidth(pb.width)
-
-	if err := form.Run(); err != nil {
 		return nil, err
 	}
-	return pb.outputSingle, nil
+	return items.choose([]*prompt.Item{chosen})[0], nil
 }
 
+// SelectMultiple asks to choose any of the items. Items marked as Selected are preselected;
+// afterwards exactly the chosen items are marked as Selected.
+// (ai generated comment)
 func SelectMultiple(opts ...PromptOption) ([]*Item, error) {
-	pb, err := newPromptBuilder(formMulti)
+	pb := newPromptBuilder(formMulti, opts...)
+	items := newItemSet(pb.items)
+	chosen, err := prompt.SelectMultiple(pb.options(items)...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create prompt builder: %v", err)
-	}
-	for _, modify := range opts {
-		modify(pb)
-	}
-	multiPrompt := huh.NewMultiSelect[*Item]().
-		Title(pb.title).
-		Description(pb.description).
-		Validate(pb.itemSetValidator).
-		Value(&pb.outputMultiple)
-
-	items := []huh.Option[*Item]{}
-	for _, item := range pb.items {
-		items = append(items, huh.NewOption(item.Key, item))
-	}
-	multiPrompt.Options(items...)
-
-	form := huh.NewForm(huh.NewGroup(multiPrompt)).
-		WithTheme(pb.theme)
-		// WithHeight(pb.height).
-		// WithWidth(pb.width)
-
-	if err := form.Run(); err != nil {
 		return nil, err
 	}
-	return pb.outputMultiple, nil
+	return items.choose(chosen), nil
 }
 
+// Confirm asks a yes/no question.
+// (ai generated comment)
 func Confirm(opts ...PromptOption) (bool, error) {
-	pb, err := newPromptBuilder(formConfirm)
-	if err != nil {
-		return false, fmt.Errorf("failed to create prompt builder: %v", err)
-	}
-	for _, modify := range opts {
-		modify(pb)
-	}
-
-	confirmPrompt := huh.NewConfirm().
-		Title(pb.title).
-		Description(pb.description).
-		Affirmative(pb.confirmAffirmative).
-		Negative(pb.confirmNegative).
-		Value(&pb.outputConfirmation)
-
-	form := huh.NewForm(huh.NewGroup(confirmPrompt)).
-		WithTheme(pb.theme)
-		// WithHeight(pb.height).
-		// WithWidth(pb.width)
-
-	if err := form.Run(); err != nil {
-		return false, err
-	}
-	return pb.outputConfirmation, nil
+	pb := newPromptBuilder(formConfirm, opts...)
+	return prompt.Confirm(pb.options(itemSet{})...)
 }
 
+// SearchItem asks to choose one of the items with a filter. The chosen item is marked as Selected.
+// (ai generated comment)
 func SearchItem(opts ...PromptOption) (*Item, error) {
-
-	search, err := newSearch(opts...)
-	if err != nil {
-		return nil, err
-	}
-	prg := tea.NewProgram(search)
-	resultState, err := prg.Run()
+	pb := newPromptBuilder(formSearch, opts...)
+	items := newItemSet(pb.items)
+	chosen, err := prompt.SearchItem(pb.options(items)...)
 	if err != nil {
 		return nil, err
 	}
-	if filteredModel, ok := resultState.(searchModel); ok {
-		return filteredModel.selectedItem, nil
-	}
-	return nil, fmt.Errorf("unexpected endpoint reached")
-
+	return items.choose([]*prompt.Item{chosen})[0], nil
 }
@@ -1,70 +1,152 @@
 package v1_test
 
 import (
-	"fmt"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/Galdoba/consolio/prompt"
-	v2 "github.com/Galdoba/consolio/prompt/v2"
+	v1 "github.com/Galdoba/consolio/prompt/v1"
 )
 
-// func TestGetInput(t *testing.T) {
-// 	answer, err := prompt.Input(prompt.WithTextValidator(prompt.Number))
-// 	fmt.Println(answer, err)
-// 	fmt.Println("")
-// }
+// lineTerminal returns an option running v1 prompts on files with the answers instead of a terminal
+func lineTerminal(t *testing.T, answers string) (v1.PromptOption, func() string) {
+	t.Helper()
+	dir := t.TempDir()
+	inPath := filepath.Join(dir, "in")
+	if err := os.WriteFile(inPath, []byte(answers), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	in, err := os.Open(inPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := os.Create(filepath.Join(dir, "out"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		in.Close()
+		out.Close()
+	})
+	output := func() string {
+		data, err := os.ReadFile(out.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	return v1.WithPromptOptions(prompt.WithInput(in), prompt.WithOutput(out)), output
+}
 
-// func TestSingleSelect(t *testing.T) {
-// 	chosen, err := prompt.SelectSingle(prompt.WithDescription("abbacab"),
-// 		prompt.FromItems(
-// 			prompt.NewItem("one", 1),
-// 			prompt.NewItem("two", "II"),
-// 			prompt.NewItem("theree", "три"),
-// 			prompt.NewItem("four", "****"),
-// 		),
-// 		prompt.WithInlineSelection(false),
-// 		prompt.WithTheme(huh.ThemeBase16()))
-// 	fmt.Println(err)
-// 	fmt.Println(chosen.Key, fmt.Sprintf("%v", chosen.Data))
-// 	fmt.Println("")
-// }
+// TestItemConversion tests conversion between v1 items and items of the current engine
+func TestItemConversion(t *testing.T) {
+	item := v1.NewItem("one", 1).Convert()
+	if item.Key() != "one" || item.Payload() != 1 {
+		t.Errorf("Convert() = %v/%v, want one/1", item.Key(), item.Payload())
+	}
+	back := v1.ConvertItem(prompt.NewItem("two", "II"), true)
+	if back.Key != "two" || back.Data != "II" || !back.Selected {
+		t.Errorf("ConvertItem() = %+v, want selected two/II", back)
+	}
+}
 
-// func TestConfirm(t *testing.T) {
-// 	confirmed, err := prompt.Confirm(prompt.WithTitle("me need confirm"), prompt.WithDescription("descr confr"))
-// 	fmt.Println(err)
-// 	fmt.Println(confirmed)
+// TestInput tests that v1 input runs with v1 title and validator on the current engine
+func TestInput(t *testing.T) {
+	term, output := lineTerminal(t, "x\n42\n")
+	answer, err := v1.Input(term, v1.WithTextValidator(v1.Number))
+	if err != nil || answer != "42" {
+		t.Fatalf("Input() = %q, %v, want 42", answer, err)
+	}
+	if !strings.Contains(output(), "user text input:") || !strings.Contains(output(), "input must be integer") {
+		t.Errorf("output should show v1 title and validation error:\n%v", output())
+	}
+}
 
-func TestV2(t *testing.T) {
-	v2.Input()
+// TestSelectSingle tests that the original item is returned and validated as v1 item
+func TestSelectSingle(t *testing.T) {
+	items := v1.NewItemList("one", "two", "three")
+	term, _ := lineTerminal(t, "2\n3\n")
+	chosen, err := v1.SelectSingle(term, v1.FromItems(items...), v1.WithInlineSelection(true),
+		v1.WithItemValidator(func(item *v1.Item) error {
+			if item.Key == "two" {
+				return errors.New("two is taken")
+			}
+			return nil
+		}))
+	if err != nil || chosen != items[2] {
+		t.Fatalf("SelectSingle() = %v, %v, want original item three", chosen, err)
+	}
+	if !chosen.Selected || items[0].Selected {
+		t.Errorf("Selected = %v/%v, want only the chosen item", items[0].Selected, chosen.Selected)
+	}
 }
 
+// TestSelectMultiple tests that Selected items are preselected and updated with the choice
 func TestSelectMultiple(t *testing.T) {
-	items := []*prompt.Item{}
-	for i := 0; i < 555; i++ {
-		items = append(items, prompt.CreateItem(fmt.Sprintf("object %v", i)))
-	}
-	chosen, err := prompt.SelectMultiple(
-		prompt.FromItems(items...),
-		prompt.WithDescription("this is test desctiption for multiselect\n with multiline"),
-	)
-	fmt.Println(err)
-	for i, selected := range chosen {
-		fmt.Println(i, selected.Key, fmt.Sprintf("%v", selected.Data))
+	items := v1.NewItemList("one", "two", "three")
+	items[1].Selected = true
+	term, _ := lineTerminal(t, "\n")
+	chosen, err := v1.SelectMultiple(term, v1.FromItems(items...))
+	if err != nil || len(chosen) != 1 || chosen[0] != items[1] {
+		t.Fatalf("SelectMultiple() = %v, %v, want preselected item two", chosen, err)
+	}
+	term, _ = lineTerminal(t, "1,3\n")
+	chosen, err = v1.SelectMultiple(term, v1.FromItems(items...))
+	if err != nil || len(chosen) != 2 || chosen[0].Data != "one" || chosen[1].Data != "three" {
+		t.Fatalf("SelectMultiple() = %v, %v, want one and three", chosen, err)
+	}
+	if !items[0].Selected || items[1].Selected || !items[2].Selected {
+		t.Errorf("Selected = %v/%v/%v, want true/false/true", items[0].Selected, items[1].Selected, items[2].Selected)
+	}
+}
 
+// TestSearchItem tests that search returns the original item and engine options apply
+func TestSearchItem(t *testing.T) {
+	items := v1.NewItemList("apple", "banana", "cherry")
+	term, output := lineTerminal(t, "an\n1\n")
+	chosen, err := v1.SearchItem(term, v1.FromItems(items...), v1.WithPromptOptions(prompt.WithTitle("Fruit")))
+	if err != nil || chosen != items[1] {
+		t.Fatalf("SearchItem() = %v, %v, want banana", chosen, err)
+	}
+	if !strings.Contains(output(), "Fruit") {
+		t.Errorf("engine option should override v1 title:\n%v", output())
 	}
-	fmt.Println("")
 }
 
-func TestSearch(t *testing.T) {
-	items := []*prompt.Item{}
-	for i := 0; i < 15; i++ {
-		items = append(items, prompt.CreateItem(fmt.Sprintf("object %v", i)))
+// TestConfirm tests that confirm runs on the current engine
+func TestConfirm(t *testing.T) {
+	term, _ := lineTerminal(t, "y\n")
+	if ok, err := v1.Confirm(term, v1.WithTitle("proceed?")); err != nil || !ok {
+		t.Errorf("Confirm() = %v, %v, want true", ok, err)
 	}
+}
 
-	item, err := prompt.SearchItem(
-		prompt.WithDescription("me descr"),
-		prompt.FromItems(items...),
-	)
-	fmt.Println(err)
-	fmt.Println(item)
+// TestItemValidatorForms tests that the v1 item validator checks multiple selection and search
+func TestItemValidatorForms(t *testing.T) {
+	noTwo := v1.WithItemValidator(func(item *v1.Item) error {
+		if item.Key == "two" {
+			return errors.New("taken")
+		}
+		return nil
+	})
+	items := v1.NewItemList("one", "two", "three")
+	term, output := lineTerminal(t, "1-2\n1,3\n")
+	chosen, err := v1.SelectMultiple(term, v1.FromItems(items...), noTwo)
+	if err != nil || len(chosen) != 2 || chosen[1] != items[2] {
+		t.Fatalf("SelectMultiple() = %v, %v, want one and three", chosen, err)
+	}
+	if !strings.Contains(output(), "two: taken") {
+		t.Errorf("output should show validation error:\n%v", output())
+	}
+	term, output = lineTerminal(t, "t\n1\n2\n")
+	found, err := v1.SearchItem(term, v1.FromItems(items...), noTwo)
+	if err != nil || found != items[2] {
+		t.Fatalf("SearchItem() = %v, %v, want three", found, err)
+	}
+	if !strings.Contains(output(), "taken") {
+		t.Errorf("output should show validation error:\n%v", output())
+	}
 }
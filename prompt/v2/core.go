@@ -1,6 +1,11 @@
 package v2
 
-import "github.com/charmbracelet/huh"
+import (
+	"fmt"
+
+	"github.com/Galdoba/consolio/prompt"
+	"github.com/charmbracelet/huh"
+)
 
 // promptType defines the types of supported prompts in the library.
 // It's used to distinguish between different prompt categories like input, selection, confirmation etc.
@@ -57,3 +62,65 @@ type promptBuilder struct {
 	settings         map[any]any      // Custom settings overriding defaults
 	defaultsRegistry DefaultsRegistry // Registry of default values for prompt types
 }
+
+// newPromptBuilder creates a builder of the prompt type with default values and applies the options.
+// Returns an error if a required option is missing.
+// (ai generated comment)
+func newPromptBuilder(pt promptType, opts ...PromptOption) (*promptBuilder, error) {
+	pb := &promptBuilder{
+		promptType:       pt,
+		settings:         map[any]any{},
+		defaultsRegistry: defaultRegistry(),
+	}
+	for _, modify := range opts {
+		modify(pb)
+	}
+	if err := validateRequiredFields(pb); err != nil {
+		return nil, fmt.Errorf("failed field validation: %v", err)
+	}
+	return pb, nil
+}
+
+// options returns options of the current prompt engine for the collected settings and the converted items.
+// Validators receive the original v2 items.
+// (ai generated comment)
+func (pb *promptBuilder) options(items itemSet) []prompt.PromptOption {
+	opts := []prompt.PromptOption{
+		prompt.WithTheme(pb.getTheme()),
+		prompt.WithTitle(pb.getTitle()),
+		prompt.WithDescription(pb.getDescription()),
+	}
+	switch pb.promptType {
+	case ptInput:
+		opts = append(opts,
+			prompt.WithPrompt(pb.getPrompt()),
+			prompt.WithPlaceholder(pb.getPlaceholder()),
+			prompt.WithStringValidator(pb.getStringValidator()))
+	case ptSelect, ptSearch:
+		validator := pb.getItemValidator()
+		opts = append(opts,
+			prompt.FromItems(items.converted),
+			prompt.WithItemValidator(func(item *prompt.Item) error {
+				return validator(items.original(item))
+			}))
+		if pb.promptType == ptSearch {
+			opts = append(opts, prompt.WithCaseSensitiveFilter(pb.getCaseSensitive()))
+		}
+	case ptSelectMulti:
+		validator := pb.getItemListValidator()
+		opts = append(opts,
+			prompt.FromItems(items.converted),
+			prompt.WithItemListValidator(func(chosen []*prompt.Item) error {
+				return validator(items.originals(chosen))
+			}))
+	case ptConfirm:
+		opts = append(opts, prompt.WithAffirmative(pb.getAffirmative()), prompt.WithNegative(pb.getNegative()))
+	}
+	if w := pb.getWidth(); w > 0 {
+		opts = append(opts, prompt.WithWidth(w))
+	}
+	if h := pb.getHeight(); h > 0 {
+		opts = append(opts, prompt.WithHeight(h))
+	}
+	return opts
+}
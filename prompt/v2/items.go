@@ -1,5 +1,7 @@
 package v2
 
+import "github.com/Galdoba/consolio/prompt"
+
 // Item represents a selectable item in list-based prompts like select, multi-select, and search.
 // It contains a display key and an optional payload for storing additional data.
 // The payload can be any type, making Items flexible for various use cases.
@@ -40,3 +42,56 @@ func (i Item) Key() string {
 func (i Item) Payload() any {
 	return i.payload
 }
+
+// Convert returns the item of the current prompt package with the same key and payload.
+// (ai generated comment)
+func (i *Item) Convert() *prompt.Item {
+	return prompt.NewItem(i.key, i.payload)
+}
+
+// ConvertItem returns the v2 item with the key and payload of the current prompt package item.
+// (ai generated comment)
+func ConvertItem(item *prompt.Item) *Item {
+	return NewItem(item.Key(), item.Payload())
+}
+
+// itemSet converts v2 items for the prompt engine and maps chosen items back to the originals.
+// (ai generated comment)
+type itemSet struct {
+	converted []*prompt.Item         // Items passed to the engine
+	origin    map[*prompt.Item]*Item // Original item of every converted one
+}
+
+// newItemSet converts the v2 items.
+// (ai generated comment)
+func newItemSet(items []*Item) itemSet {
+	is := itemSet{origin: map[*prompt.Item]*Item{}}
+	for _, item := range items {
+		converted := item.Convert()
+		is.converted = append(is.converted, converted)
+		is.origin[converted] = item
+	}
+	return is
+}
+
+// original returns the v2 item the engine item was converted from.
+// (ai generated comment)
+func (is itemSet) original(item *prompt.Item) *Item {
+	if item == nil {
+		return nil
+	}
+	if v2Item, ok := is.origin[item]; ok {
+		return v2Item
+	}
+	return ConvertItem(item)
+}
+
+// originals returns the v2 items the engine items were converted from.
+// (ai generated comment)
+func (is itemSet) originals(items []*prompt.Item) []*Item {
+	result := []*Item{}
+	for _, item := range items {
+		result = append(result, is.original(item))
+	}
+	return result
+}
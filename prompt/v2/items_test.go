@@ -71,3 +71,21 @@ func TestItemMethods(t *testing.T) {
 		t.Errorf("Payload() = %v, want %v", item.Payload(), "test_payload")
 	}
 }
+
+// TestItemSet tests that items chosen by the prompt engine map back to the original v2 items
+func TestItemSet(t *testing.T) {
+	items := []*Item{NewItem("a", 1), NewItem("b", 2)}
+	is := newItemSet(items)
+	if len(is.converted) != 2 || is.converted[1].Key() != "b" || is.converted[1].Payload() != 2 {
+		t.Fatalf("converted = %v, want items a and b with payloads", is.converted)
+	}
+	if got := is.originals(is.converted); got[0] != items[0] || got[1] != items[1] {
+		t.Errorf("originals() = %v, want the original items", got)
+	}
+	if got := is.original(nil); got != nil {
+		t.Errorf("original(nil) = %v, want nil", got)
+	}
+	if got := is.original(items[0].Convert()); got == items[0] || got.Key() != "a" {
+		t.Errorf("original() of an unknown item = %v, want a new item a", got)
+	}
+}
@@ -1,10 +1,12 @@
+// Package v2 keeps the second prompt API for older tools. Prompts run on the current engine
+// of package prompt, so its fixes and features (line prompts without a terminal, accessible mode,
+// localization) apply to v2 callers as well. To migrate, convert items with Item.Convert
+// and call the functions of package prompt with the options of the same names.
+// (ai generated comment)
 package v2
 
 import (
-	"fmt"
-
-	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/huh"
+	"github.com/Galdoba/consolio/prompt"
 )
 
 // Input displays a text input prompt and returns the user's string input.
@@ -12,35 +14,11 @@ import (
 // Returns the entered string or an error if the prompt fails.
 // (ai generated comment)
 func Input(opts ...PromptOption) (string, error) {
-	pb := &promptBuilder{
-		promptType:       ptInput,
-		settings:         map[any]any{},
-		defaultsRegistry: defaultRegistry(),
-	}
-	for _, modify := range opts {
-		modify(pb)
-	}
-	if err := validateRequiredFields(pb); err != nil {
-		return "", fmt.Errorf("failed field validation: %v", err)
-	}
-	val := ""
-	input := huh.NewInput().
-		Title(pb.getTitle()).
-		Description(pb.getDescription()).
-		Prompt(pb.getPrompt()).
-		Placeholder(pb.getPlaceholder()).
-		Validate(pb.getStringValidator()).
-		Value(&val)
-
-	form := huh.NewForm(huh.NewGroup(input)).
-		WithHeight(pb.getHeight()).
-		WithWidth(pb.getWidth()).
-		WithTheme(pb.getTheme())
-	if err := form.Run(); err != nil {
+	pb, err := newPromptBuilder(ptInput, opts...)
+	if err != nil {
 		return "", err
 	}
-
-	return val, nil
+	return prompt.Input(pb.options(itemSet{})...)
 }
 
 // SelectSingle displays a single-selection prompt from a list of items.
@@ -48,52 +26,16 @@ func Input(opts ...PromptOption) (string, error) {
 // Returns the selected Item or an error if selection fails.
 // (ai generated comment)
 func SelectSingle(opts ...PromptOption) (*Item, error) {
-	pb := &promptBuilder{
-		promptType:       ptSelect,
-		settings:         map[any]any{},
-		defaultsRegistry: defaultRegistry(),
-	}
-	for _, modify := range opts {
-		modify(pb)
-	}
-	if err := validateRequiredFields(pb); err != nil {
-		return nil, fmt.Errorf("failed field validation: %v", err)
-	}
-	val := new(Item)
-	items, err := getFrom(pb, KeyItems)
+	pb, err := newPromptBuilder(ptSelect, opts...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve selection list: %v", err)
-	}
-	switch len(items) {
-	case 0:
-		return nil, fmt.Errorf("item pool is empty")
-	case 1:
-		return items[0], nil
-	}
-	options := huh.NewOptions[*Item]()
-	for i, item := range items {
-		if err := defaultItemValidationFunc(item); err != nil {
-			return nil, fmt.Errorf("bad item list: item %v: %v", i, err)
-		}
-		options = append(options, huh.NewOption(item.Key(), item))
+		return nil, err
 	}
-
-	selector := huh.NewSelect[*Item]().
-		Title(pb.getTitle()).
-		Description(pb.getDescription()).
-		Value(&val).
-		Validate(pb.getItemValidator()).
-		Options(options...)
-
-	form := huh.NewForm(huh.NewGroup(selector)).
-		WithHeight(pb.getHeight()).
-		WithWidth(pb.getWidth()).
-		WithTheme(pb.getTheme())
-	if err := form.Run(); err != nil {
+	items := newItemSet(pb.getItems())
+	chosen, err := prompt.SelectSingle(pb.options(items)...)
+	if err != nil {
 		return nil, err
 	}
-
-	return val, nil
+	return items.original(chosen), nil
 }
 
 // SelectMultiple displays a multiple-selection prompt from a list of items.
@@ -101,50 +43,16 @@ func SelectSingle(opts ...PromptOption) (*Item, error) {
 // Returns a slice of selected Items or an error if selection fails.
 // (ai generated comment)
 func SelectMultiple(opts ...PromptOption) ([]*Item, error) {
-	pb := &promptBuilder{
-		promptType:       ptSelectMulti,
-		settings:         map[any]any{},
-		defaultsRegistry: defaultRegistry(),
-	}
-	for _, modify := range opts {
-		modify(pb)
-	}
-	if err := validateRequiredFields(pb); err != nil {
-		return nil, fmt.Errorf("failed field validation: %v", err)
-	}
-	val := new([]*Item)
-	items, err := getFrom(pb, KeyItems)
+	pb, err := newPromptBuilder(ptSelectMulti, opts...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve selection list: %v", err)
-	}
-	switch len(items) {
-	case 0:
-		return nil, fmt.Errorf("item pool is empty")
-	}
-	options := huh.NewOptions[*Item]()
-	for i, item := range items {
-		if err := defaultItemValidationFunc(item); err != nil {
-			return nil, fmt.Errorf("bad item list: item %v: %v", i, err)
-		}
-		options = append(options, huh.NewOption(item.Key(), item))
+		return nil, err
 	}
-
-	selector := huh.NewMultiSelect[*Item]().
-		Title(pb.getTitle()).
-		Description(pb.getDescription()).
-		Value(val).
-		Validate(pb.getItemListValidator()).
-		Options(options...)
-
-	form := huh.NewForm(huh.NewGroup(selector)).
-		WithHeight(pb.getHeight()).
-		WithWidth(pb.getWidth()).
-		WithTheme(pb.getTheme())
-	if err := form.Run(); err != nil {
+	items := newItemSet(pb.getItems())
+	chosen, err := prompt.SelectMultiple(pb.options(items)...)
+	if err != nil {
 		return nil, err
 	}
-
-	return *val, nil
+	return items.originals(chosen), nil
 }
 
 // Confirm displays a yes/no confirmation prompt.
@@ -152,34 +60,11 @@ func SelectMultiple(opts ...PromptOption) ([]*Item, error) {
 // Returns a boolean indicating the user's choice or an error if prompt fails.
 // (ai generated comment)
 func Confirm(opts ...PromptOption) (bool, error) {
-	pb := &promptBuilder{
-		promptType:       ptConfirm,
-		settings:         map[any]any{},
-		defaultsRegistry: defaultRegistry(),
-	}
-	for _, modify := range opts {
-		modify(pb)
-	}
-	if err := validateRequiredFields(pb); err != nil {
-		return false, fmt.Errorf("failed field validation: %v", err)
-	}
-	val := false
-	input := huh.NewConfirm().
-		Title(pb.getTitle()).
-		Description(pb.getDescription()).
-		Affirmative(pb.getAffirmative()).
-		Negative(pb.getNegative()).
-		Value(&val)
-
-	form := huh.NewForm(huh.NewGroup(input)).
-		WithHeight(pb.getHeight()).
-		WithWidth(pb.getWidth()).
-		WithTheme(pb.getTheme())
-	if err := form.Run(); err != nil {
+	pb, err := newPromptBuilder(ptConfirm, opts...)
+	if err != nil {
 		return false, err
 	}
-
-	return val, nil
+	return prompt.Confirm(pb.options(itemSet{})...)
 }
 
 // SearchItem displays an interactive search prompt with real-time filtering.
@@ -187,18 +72,14 @@ func Confirm(opts ...PromptOption) (bool, error) {
 // Returns the selected Item or an error if search is canceled or fails.
 // (ai generated comment)
 func SearchItem(opts ...PromptOption) (*Item, error) {
-	search, err := newSearch(opts...)
+	pb, err := newPromptBuilder(ptSearch, opts...)
 	if err != nil {
 		return nil, err
 	}
-	prg := tea.NewProgram(search)
-	resultState, err := prg.Run()
+	items := newItemSet(pb.getItems())
+	chosen, err := prompt.SearchItem(pb.options(items)...)
 	if err != nil {
 		return nil, err
 	}
-	if filteredModel, ok := resultState.(searchModel); ok {
-		return filteredModel.selectedItem, filteredModel.err
-	}
-	return nil, fmt.Errorf("unexpected endpoint reached")
-
+	return items.original(chosen), nil
 }
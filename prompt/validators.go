@@ -1,8 +1,18 @@
 package prompt
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // StringValidatorFunc is a function type that validates string input.
@@ -38,6 +48,29 @@ func Float64(s string) error {
 	return nil
 }
 
+// Duration validates that a string can be parsed as a duration.
+// Accepts units of time.ParseDuration plus "d" (days) and "w" (weeks).
+// Returns an error if the string cannot be parsed as a duration.
+// (ai generated comment)
+func Duration(s string) error {
+	if _, err := ParseDuration(s); err != nil {
+		return fmt.Errorf("input must be duration")
+	}
+	return nil
+}
+
+// TimeFormat creates a validator checking that a string matches the given time layout.
+// Layout uses the reference time format of the time package.
+// (ai generated comment)
+func TimeFormat(layout string) StringValidatorFunc {
+	return func(s string) error {
+		if _, err := time.Parse(layout, s); err != nil {
+			return fmt.Errorf("input must match layout %v", layout)
+		}
+		return nil
+	}
+}
+
 // ItemValidationFunc is a function type that validates individual Item objects.
 // Used to validate items in selection-based prompts.
 // Returns an error if the item fails validation.
@@ -89,3 +122,332 @@ func defaultItemListValidationFunc(items []*Item) error {
 	}
 	return nil
 }
+
+// StringListValidatorFunc is a function type that validates lists of strings.
+// Used to validate all values collected by list input prompts.
+// Returns an error if the list fails validation.
+// (ai generated comment)
+type StringListValidatorFunc func([]string) error
+
+// defaultStringListValidatorFunc is the default string list validator function.
+// It accepts any list, including an empty one.
+// (ai generated comment)
+var defaultStringListValidatorFunc StringListValidatorFunc = func([]string) error { return nil }
+
+// All combines validators into one that fails with the first error encountered.
+// Warnings do not stop validation: the first warning is returned only if no validator fails.
+// Works with StringValidatorFunc, ItemValidationFunc, ItemListValidationFunc
+// and any other func(T) error validator.
+// (ai generated comment)
+func All[T any](validators ...func(T) error) func(T) error {
+	return func(v T) error {
+		var warning error
+		for _, validate := range validators {
+			err := validate(v)
+			switch {
+			case isBlocking(err):
+				return err
+			case err != nil && warning == nil:
+				warning = err
+			}
+		}
+		return warning
+	}
+}
+
+// Any combines validators into one that passes if at least one of them passes.
+// A validator passing with warning counts as passed; its warning is returned
+// only if no validator passes cleanly. If all fail, errors are joined with "or".
+// (ai generated comment)
+func Any[T any](validators ...func(T) error) func(T) error {
+	return func(v T) error {
+		var warning error
+		messages := []string{}
+		for _, validate := range validators {
+			err := validate(v)
+			switch {
+			case err == nil:
+				return nil
+			case IsWarning(err):
+				if warning == nil {
+					warning = err
+				}
+			default:
+				messages = append(messages, err.Error())
+			}
+		}
+		if warning != nil || len(messages) == 0 {
+			return warning
+		}
+		return errors.New(strings.Join(messages, " or "))
+	}
+}
+
+// Not inverts a validator: it fails with the message when the validator passes.
+// Warnings count as passing.
+// (ai generated comment)
+func Not[T any](validator func(T) error, message string) func(T) error {
+	return func(v T) error {
+		if !isBlocking(validator(v)) {
+			return errors.New(message)
+		}
+		return nil
+	}
+}
+
+// WithMessage replaces the error message of a failing validator.
+// Warnings keep being warnings with the new message.
+// (ai generated comment)
+func WithMessage[T any](validator func(T) error, message string) func(T) error {
+	return func(v T) error {
+		err := validator(v)
+		switch {
+		case err == nil:
+			return nil
+		case IsWarning(err):
+			return &Warning{Message: message}
+		}
+		return errors.New(message)
+	}
+}
+
+// AsWarningValidator turns failures of a validator into non-blocking warnings.
+// (ai generated comment)
+func AsWarningValidator[T any](validator func(T) error) func(T) error {
+	return func(v T) error {
+		if err := validator(v); err != nil {
+			return &Warning{Message: err.Error()}
+		}
+		return nil
+	}
+}
+
+// NonEmpty validates that a string contains non-whitespace characters.
+// (ai generated comment)
+func NonEmpty(s string) error {
+	if strings.TrimSpace(s) == "" {
+		return fmt.Errorf("input must not be empty")
+	}
+	return nil
+}
+
+// MinLength creates a validator checking that a string has at least n characters.
+// (ai generated comment)
+func MinLength(n int) StringValidatorFunc {
+	return func(s string) error {
+		if glyphsLen(s) < n {
+			return fmt.Errorf("input must be at least %v characters long", n)
+		}
+		return nil
+	}
+}
+
+// MaxLength creates a validator checking that a string has at most n characters.
+// (ai generated comment)
+func MaxLength(n int) StringValidatorFunc {
+	return func(s string) error {
+		if glyphsLen(s) > n {
+			return fmt.Errorf("input must be at most %v characters long", n)
+		}
+		return nil
+	}
+}
+
+// Regexp creates a validator checking that a string matches the regular expression.
+// Panics if the expression can not be compiled, like regexp.MustCompile.
+// (ai generated comment)
+func Regexp(pattern string) StringValidatorFunc {
+	re := regexp.MustCompile(pattern)
+	return func(s string) error {
+		if !re.MatchString(s) {
+			return fmt.Errorf("input must match %v", pattern)
+		}
+		return nil
+	}
+}
+
+// IntRange creates a validator checking that a string is an integer between minValue and maxValue inclusive.
+// (ai generated comment)
+func IntRange(minValue, maxValue int) StringValidatorFunc {
+	return func(s string) error {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < minValue || n > maxValue {
+			return fmt.Errorf("input must be integer from %v to %v", minValue, maxValue)
+		}
+		return nil
+	}
+}
+
+// FloatRange creates a validator checking that a string is a number between minValue and maxValue inclusive.
+// (ai generated comment)
+func FloatRange(minValue, maxValue float64) StringValidatorFunc {
+	return func(s string) error {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil || f < minValue || f > maxValue {
+			return fmt.Errorf("input must be number from %v to %v", minValue, maxValue)
+		}
+		return nil
+	}
+}
+
+// OneOf creates a validator checking that a string is one of the allowed values.
+// (ai generated comment)
+func OneOf(values ...string) StringValidatorFunc {
+	return func(s string) error {
+		if !slices.Contains(values, s) {
+			return fmt.Errorf("input must be one of: %v", strings.Join(values, ", "))
+		}
+		return nil
+	}
+}
+
+// Email validates that a string is a plain email address (without display name).
+// (ai generated comment)
+func Email(s string) error {
+	addr, err := mail.ParseAddress(s)
+	if err != nil || addr.Address != s {
+		return fmt.Errorf("input must be email address")
+	}
+	return nil
+}
+
+// URL validates that a string is an absolute URL with scheme and host.
+// (ai generated comment)
+func URL(s string) error {
+	u, err := url.ParseRequestURI(s)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("input must be absolute URL")
+	}
+	return nil
+}
+
+// hostnameLabel matches a single hostname label as defined in RFC 1123.
+// (ai generated comment)
+var hostnameLabel = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// Hostname validates that a string is a hostname as defined in RFC 1123.
+// (ai generated comment)
+func Hostname(s string) error {
+	s = strings.TrimSuffix(s, ".")
+	if s == "" || len(s) > 253 {
+		return fmt.Errorf("input must be hostname")
+	}
+	for _, label := range strings.Split(s, ".") {
+		if !hostnameLabel.MatchString(label) {
+			return fmt.Errorf("input must be hostname")
+		}
+	}
+	return nil
+}
+
+// IP validates that a string is an IPv4 or IPv6 address.
+// (ai generated comment)
+func IP(s string) error {
+	if net.ParseIP(s) == nil {
+		return fmt.Errorf("input must be IP address")
+	}
+	return nil
+}
+
+// CIDR validates that a string is an IP network in CIDR notation.
+// (ai generated comment)
+func CIDR(s string) error {
+	if _, _, err := net.ParseCIDR(s); err != nil {
+		return fmt.Errorf("input must be CIDR network")
+	}
+	return nil
+}
+
+// Port validates that a string is a TCP/UDP port number from 1 to 65535.
+// (ai generated comment)
+func Port(s string) error {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 || n > 65535 {
+		return fmt.Errorf("input must be port number")
+	}
+	return nil
+}
+
+// semverPattern matches semantic versions as defined by semver.org, with optional "v" prefix.
+// (ai generated comment)
+var semverPattern = regexp.MustCompile(`^v?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(-(0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(\.(0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*)?` +
+	`(\+[0-9a-zA-Z-]+(\.[0-9a-zA-Z-]+)*)?$`)
+
+// Semver validates that a string is a semantic version like "1.2.3" or "v1.0.0-rc.1".
+// (ai generated comment)
+func Semver(s string) error {
+	if !semverPattern.MatchString(s) {
+		return fmt.Errorf("input must be semantic version")
+	}
+	return nil
+}
+
+// JSON validates that a string is a valid JSON document.
+// (ai generated comment)
+func JSON(s string) error {
+	if !json.Valid([]byte(s)) {
+		return fmt.Errorf("input must be valid JSON")
+	}
+	return nil
+}
+
+// ExistingFile validates that a string is a path to an existing regular file.
+// (ai generated comment)
+func ExistingFile(s string) error {
+	info, err := os.Stat(s)
+	if err != nil || !info.Mode().IsRegular() {
+		return fmt.Errorf("input must be existing file")
+	}
+	return nil
+}
+
+// ExistingDir validates that a string is a path to an existing directory.
+// (ai generated comment)
+func ExistingDir(s string) error {
+	info, err := os.Stat(s)
+	if err != nil || !info.IsDir() {
+		return fmt.Errorf("input must be existing directory")
+	}
+	return nil
+}
+
+// MinCount creates a list validator checking that at least n values are given.
+// Use MinCount[*Item] for item lists and MinCount[string] for string lists.
+// (ai generated comment)
+func MinCount[T any](n int) func([]T) error {
+	return func(list []T) error {
+		if len(list) < n {
+			return fmt.Errorf("at least %v values required", n)
+		}
+		return nil
+	}
+}
+
+// MaxCount creates a list validator checking that at most n values are given.
+// Use MaxCount[*Item] for item lists and MaxCount[string] for string lists.
+// (ai generated comment)
+func MaxCount[T any](n int) func([]T) error {
+	return func(list []T) error {
+		if len(list) > n {
+			return fmt.Errorf("at most %v values allowed", n)
+		}
+		return nil
+	}
+}
+
+// UniqueKeys validates that no two items in the list share the same key.
+// (ai generated comment)
+func UniqueKeys(items []*Item) error {
+	seen := make(map[string]bool)
+	for _, item := range items {
+		if item == nil {
+			return fmt.Errorf("item is nil")
+		}
+		if seen[item.key] {
+			return fmt.Errorf("duplicate key %q", item.key)
+		}
+		seen[item.key] = true
+	}
+	return nil
+}
@@ -2,6 +2,8 @@ package prompt
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -61,6 +63,43 @@ func TestFloat64Validator(t *testing.T) {
 	}
 }
 
+// TestDurationValidator tests the Duration validation function
+func TestDurationValidator(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected error
+	}{
+		{"valid minutes", "90m", nil},
+		{"valid days", "3d", nil},
+		{"missing unit", "90", errors.New("input must be duration")},
+		{"empty string", "", errors.New("input must be duration")},
+		{"sign only", "-", errors.New("input must be duration")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Duration(tt.input)
+
+			if (err == nil) != (tt.expected == nil) {
+				t.Errorf("Duration(%q) error = %v, want %v", tt.input, err, tt.expected)
+			}
+		})
+	}
+}
+
+// TestTimeFormatValidator tests the TimeFormat validator constructor
+func TestTimeFormatValidator(t *testing.T) {
+	validator := TimeFormat("2006-01-02")
+
+	if err := validator("2025-03-12"); err != nil {
+		t.Errorf("TimeFormat() valid input error = %v", err)
+	}
+	if err := validator("12.03.2025"); err == nil {
+		t.Error("TimeFormat() should reject input with wrong layout")
+	}
+}
+
 // TestDefaultItemValidation tests the default item validation
 func TestDefaultItemValidation(t *testing.T) {
 	tests := []struct {
@@ -123,3 +162,104 @@ func TestDefaultItemListValidation(t *testing.T) {
 		})
 	}
 }
+
+// TestValidatorCombinators tests All, Any, Not and WithMessage
+func TestValidatorCombinators(t *testing.T) {
+	short := All(NonEmpty, Integer, MaxLength(3))
+	anyNumber := Any(Integer, WithMessage(Float64, "input must be number"))
+	notAdmin := Not(OneOf("admin", "root"), "reserved name")
+	tests := []struct {
+		name      string
+		validator StringValidatorFunc
+		input     string
+		expected  string
+	}{
+		{"all pass", short, "123", ""},
+		{"all first error", short, " ", "input must not be empty"},
+		{"all later error", short, "1234", "input must be at most 3 characters long"},
+		{"any pass", anyNumber, "1.5", ""},
+		{"any fail", anyNumber, "x", "input must be integer or input must be number"},
+		{"not pass", notAdmin, "alice", ""},
+		{"not fail", notAdmin, "root", "reserved name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ""
+			if err := tt.validator(tt.input); err != nil {
+				got = err.Error()
+			}
+			if got != tt.expected {
+				t.Errorf("validator(%q) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+	if Any[string]()("x") != nil {
+		t.Error("Any() without validators should pass")
+	}
+}
+
+// TestValidatorLibrary tests built-in string validators
+func TestValidatorLibrary(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "file.txt")
+	if err := os.WriteFile(file, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name      string
+		validator StringValidatorFunc
+		valid     []string
+		invalid   []string
+	}{
+		{"MinLength", MinLength(2), []string{"ab", "абв"}, []string{"", "я"}},
+		{"Regexp", Regexp(`^[a-z]+$`), []string{"abc"}, []string{"ab1", ""}},
+		{"IntRange", IntRange(1, 10), []string{"1", "10"}, []string{"0", "11", "x"}},
+		{"FloatRange", FloatRange(0, 1), []string{"0", "0.5"}, []string{"-0.1", "x"}},
+		{"OneOf", OneOf("a", "b"), []string{"a"}, []string{"c", ""}},
+		{"Email", Email, []string{"user@example.com"}, []string{"user", "Bob <bob@example.com>"}},
+		{"URL", URL, []string{"https://example.com/path"}, []string{"example.com", "/path"}},
+		{"Hostname", Hostname, []string{"localhost", "a-b.example.com"}, []string{"-a.com", "a..b", ""}},
+		{"IP", IP, []string{"10.0.0.1", "::1"}, []string{"10.0.0.256"}},
+		{"CIDR", CIDR, []string{"10.0.0.0/8"}, []string{"10.0.0.1"}},
+		{"Port", Port, []string{"1", "65535"}, []string{"0", "65536", "http"}},
+		{"Semver", Semver, []string{"1.2.3", "v1.0.0-rc.1+build.5"}, []string{"1.2", "01.2.3"}},
+		{"JSON", JSON, []string{`{"a":1}`, "[]"}, []string{"{", ""}},
+		{"ExistingFile", ExistingFile, []string{file}, []string{dir, filepath.Join(dir, "missing")}},
+		{"ExistingDir", ExistingDir, []string{dir}, []string{file}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, s := range tt.valid {
+				if err := tt.validator(s); err != nil {
+					t.Errorf("%v(%q) error = %v, want nil", tt.name, s, err)
+				}
+			}
+			for _, s := range tt.invalid {
+				if err := tt.validator(s); err == nil {
+					t.Errorf("%v(%q) expected error", tt.name, s)
+				}
+			}
+		})
+	}
+}
+
+// TestListValidators tests count and uniqueness validators for lists
+func TestListValidators(t *testing.T) {
+	items := []*Item{NewItem("a"), NewItem("b"), NewItem("a", 2)}
+	validate := ItemListValidationFunc(All(MinCount[*Item](1), MaxCount[*Item](3)))
+	if err := validate(items); err != nil {
+		t.Errorf("count validator error = %v", err)
+	}
+	if err := validate(nil); err == nil {
+		t.Error("expected error for empty list")
+	}
+	if err := UniqueKeys(items); err == nil {
+		t.Error("expected duplicate key error")
+	}
+	if err := UniqueKeys(items[:2]); err != nil {
+		t.Errorf("UniqueKeys() error = %v", err)
+	}
+	if err := MaxCount[string](1)([]string{"a", "b"}); err == nil {
+		t.Error("expected error for too many strings")
+	}
+}
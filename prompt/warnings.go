@@ -0,0 +1,141 @@
+package prompt
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Warning is a validation result that does not block submit.
+// Validators return it instead of an error to inform the user about a questionable value,
+// e.g. "port below 1024 needs root". Prompts display it under the field in warning style.
+// (ai generated comment)
+type Warning struct {
+	Message string // Text shown to the user
+}
+
+// Error returns the warning message, so Warning can be returned by validators.
+// (ai generated comment)
+func (w *Warning) Error() string {
+	return w.Message
+}
+
+// Warn creates a non-blocking validation warning with a formatted message.
+// (ai generated comment)
+func Warn(format string, args ...any) error {
+	return &Warning{Message: fmt.Sprintf(format, args...)}
+}
+
+// AsWarning returns the warning if err is or wraps a Warning.
+// (ai generated comment)
+func AsWarning(err error) (*Warning, bool) {
+	var w *Warning
+	if errors.As(err, &w) {
+		return w, true
+	}
+	return nil, false
+}
+
+// IsWarning reports whether err is or wraps a non-blocking Warning.
+// (ai generated comment)
+func IsWarning(err error) bool {
+	_, ok := AsWarning(err)
+	return ok
+}
+
+// isBlocking reports whether validation result prevents submit.
+// (ai generated comment)
+func isBlocking(err error) bool {
+	return err != nil && !IsWarning(err)
+}
+
+// blockingOnly wraps a validator so warnings are not reported as errors.
+// Used for huh fields which block submit on any error.
+// (ai generated comment)
+func blockingOnly[T any](validator func(T) error) func(T) error {
+	return func(v T) error {
+		if err := validator(v); isBlocking(err) {
+			return err
+		}
+		return nil
+	}
+}
+
+// warningStyle returns the style used to render warnings with the theme.
+// (ai generated comment)
+func warningStyle(theme *huh.Theme) lipgloss.Style {
+	return theme.Focused.ErrorMessage.Foreground(lipgloss.AdaptiveColor{Light: "#B58900", Dark: "#E5C07B"})
+}
+
+// warningDescription returns a huh description function appending the warning
+// reported by validator for the current value to the description.
+// (ai generated comment)
+func warningDescription[T any](theme *huh.Theme, description string, validator func(T) error, value *T) func() string {
+	return func() string {
+		w, ok := AsWarning(validator(*value))
+		if !ok {
+			return description
+		}
+		if description != "" {
+			description += "\n"
+		}
+		return description + warningStyle(theme).Render("⚠ "+w.Message)
+	}
+}
+
+// warningState tracks the warning shown for the current value of a prompt
+// and whether the user confirmed proceeding despite it.
+// (ai generated comment)
+type warningState struct {
+	confirm      bool   // Whether submit with warning needs a second confirmation
+	message      string // Current warning message
+	acknowledged bool   // Whether the warning was shown on submit and awaits confirmation
+}
+
+// show updates the displayed warning from a validation result of a changed value.
+// Pending confirmation is discarded.
+// (ai generated comment)
+func (ws *warningState) show(err error) {
+	ws.message = ""
+	ws.acknowledged = false
+	if w, ok := AsWarning(err); ok {
+		ws.message = w.Message
+	}
+}
+
+// check processes the validation result on submit.
+// Returns blocking error, or whether submit may proceed: with confirmation enabled
+// the first submit with warning only shows it and the second one proceeds.
+// (ai generated comment)
+func (ws *warningState) check(err error) (bool, error) {
+	if isBlocking(err) {
+		ws.show(nil)
+		return false, err
+	}
+	w, ok := AsWarning(err)
+	if !ok {
+		ws.show(nil)
+		return true, nil
+	}
+	ws.message = w.Message
+	if !ws.confirm || ws.acknowledged {
+		return true, nil
+	}
+	ws.acknowledged = true
+	return false, nil
+}
+
+// view renders the current warning and the confirmation request if pending.
+// (ai generated comment)
+func (ws *warningState) view(theme *huh.Theme, msgs localizer) string {
+	if ws.message == "" {
+		return ""
+	}
+	s := startLine(theme) + warningStyle(theme).Render("⚠ "+ws.message)
+	if ws.acknowledged {
+		s += startLine(theme) + theme.Focused.Description.Render(msgs.t("press enter again to proceed anyway"))
+	}
+	return s
+}
@@ -0,0 +1,165 @@
+package prompt
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// lowPort is a validator warning about privileged ports and rejecting non-numbers
+func lowPort(s string) error {
+	if err := Port(s); err != nil {
+		return err
+	}
+	if strings.HasPrefix(s, "8") && len(s) == 2 {
+		return Warn("port %v needs root", s)
+	}
+	return nil
+}
+
+// TestWarning tests creation and detection of warnings
+func TestWarning(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", Warn("port %v needs root", 80))
+	w, ok := AsWarning(err)
+	if !ok || w.Message != "port 80 needs root" {
+		t.Errorf("AsWarning() = %v, %v, want port 80 needs root", w, ok)
+	}
+	if IsWarning(errors.New("plain")) || IsWarning(nil) {
+		t.Error("plain errors and nil are not warnings")
+	}
+	if isBlocking(err) || !isBlocking(errors.New("plain")) || isBlocking(nil) {
+		t.Error("only plain errors should block")
+	}
+	if blockingOnly(lowPort)("80") != nil || blockingOnly(lowPort)("x") == nil {
+		t.Error("blockingOnly() should drop warnings and keep errors")
+	}
+}
+
+// TestWarningStateCheck tests submit decisions with and without confirmation
+func TestWarningStateCheck(t *testing.T) {
+	ws := warningState{}
+	if proceed, err := ws.check(Warn("careful")); !proceed || err != nil {
+		t.Errorf("check() = %v, %v, want proceed without confirmation", proceed, err)
+	}
+	if proceed, err := ws.check(errors.New("bad")); proceed || err == nil {
+		t.Errorf("check() = %v, %v, want blocking error", proceed, err)
+	}
+
+	ws = warningState{confirm: true}
+	if proceed, _ := ws.check(Warn("careful")); proceed || !ws.acknowledged {
+		t.Fatal("first submit with warning should ask for confirmation")
+	}
+	if view := ws.view(newTestBuilder(t, ptInput).getTheme(), localizer{}); !strings.Contains(view, "careful") || !strings.Contains(view, "again") {
+		t.Errorf("view() = %q, want warning and confirmation request", view)
+	}
+	if proceed, _ := ws.check(Warn("careful")); !proceed {
+		t.Error("second submit should proceed")
+	}
+	ws.show(Warn("changed"))
+	if ws.acknowledged || ws.message != "changed" {
+		t.Error("show() should reset confirmation")
+	}
+}
+
+// TestCombinatorsWithWarnings tests that combinators keep warnings non-blocking
+func TestCombinatorsWithWarnings(t *testing.T) {
+	if err := All(lowPort, MaxLength(1))("80"); !isBlocking(err) {
+		t.Errorf("All() = %v, want blocking error over warning", err)
+	}
+	if err := All(lowPort, NonEmpty)("80"); !IsWarning(err) {
+		t.Errorf("All() = %v, want warning", err)
+	}
+	if err := Any(lowPort, Email)("80"); !IsWarning(err) {
+		t.Errorf("Any() = %v, want warning to pass", err)
+	}
+	if err := Any(lowPort, NonEmpty)("80"); err != nil {
+		t.Errorf("Any() = %v, want clean pass over warning", err)
+	}
+	if err := WithMessage(lowPort, "check port")("80"); !IsWarning(err) {
+		t.Errorf("WithMessage() = %v, want warning", err)
+	}
+	if err := AsWarningValidator(MaxLength(1))("80"); !IsWarning(err) {
+		t.Errorf("AsWarningValidator() = %v, want warning", err)
+	}
+}
+
+// TestInputModelWarning tests that a warning is shown and needs confirmation when enabled
+func TestInputModelWarning(t *testing.T) {
+	var m tea.Model = newTestInputModel(WithStringValidator(lowPort))
+	m = typeText(m, "80")
+	if !strings.Contains(m.View(), "needs root") {
+		t.Error("warning should be rendered while typing")
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if im := m.(inputModel); !im.done || im.value != "80" {
+		t.Errorf("value = %q (done %v), want submitted 80", im.value, im.done)
+	}
+
+	m = newTestInputModel(WithStringValidator(lowPort), WithWarningConfirmation(true))
+	m = typeText(m, "80")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.(inputModel).done {
+		t.Fatal("first enter should ask for confirmation")
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if im := m.(inputModel); !im.done || im.value != "80" {
+		t.Errorf("value = %q (done %v), want confirmed 80", im.value, im.done)
+	}
+}
+
+// TestListModelWarning tests that values with warnings are added
+func TestListModelWarning(t *testing.T) {
+	m := newTestListModel(t, WithStringValidator(lowPort))
+	m = typeText(m, "80")
+	m = pressKeys(m, tea.KeyEnter)
+	lm := m.(listModel)
+	if len(lm.values) != 1 || lm.valErr != nil {
+		t.Errorf("values = %v (error %v), want value with warning added", lm.values, lm.valErr)
+	}
+	if !strings.Contains(lm.View(), "80: port 80 needs root") {
+		t.Error("warning should name the value")
+	}
+}
+
+// TestSelectModelWarning tests confirmation of a highlighted item with warning
+func TestSelectModelWarning(t *testing.T) {
+	var m tea.Model = newTestSelectModel(t, ptSelect,
+		WithItemValidator(func(item *Item) error {
+			if item.Key() == "apple" {
+				return Warn("apples are expensive")
+			}
+			return nil
+		}),
+		WithWarningConfirmation(true),
+	)
+	if !strings.Contains(m.View(), "expensive") {
+		t.Error("warning of highlighted item should be rendered")
+	}
+	m = pressKeys(m, tea.KeyEnter)
+	if m.(selectModel).done {
+		t.Fatal("first enter should ask for confirmation")
+	}
+	m = pressKeys(m, tea.KeyEnter)
+	if sm := m.(selectModel); !sm.done || sm.selected[0].Key() != "apple" {
+		t.Errorf("selected = %v (done %v), want apple", sm.selected, sm.done)
+	}
+}
+
+// TestMapModelWarning tests that rows with warnings are committed
+func TestMapModelWarning(t *testing.T) {
+	m := newTestMapModel(t, FromMap(map[string]string{"PORT": "8080"}), WithValueValidator(lowPort))
+	m = pressKeys(m, tea.KeyEnter, tea.KeyTab)
+	m = pressKeys(m, tea.KeyBackspace, tea.KeyBackspace, tea.KeyBackspace, tea.KeyBackspace)
+	m = typeText(m, "80")
+	m = pressKeys(m, tea.KeyEnter)
+	mm := m.(mapModel)
+	if mm.editing || mm.rows[0].value != "80" {
+		t.Errorf("row = %v (editing %v), want committed 80", mm.rows[0], mm.editing)
+	}
+	if !strings.Contains(mm.View(), "value: port 80 needs root") {
+		t.Error("warning should be rendered under the table")
+	}
+}